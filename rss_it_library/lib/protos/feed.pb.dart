@@ -10,6 +10,7 @@
 
 import 'dart:core' as $core;
 
+import 'package:fixnum/fixnum.dart' as $fixnum;
 import 'package:protobuf/protobuf.dart' as $pb;
 
 class ErrorKind extends $pb.ProtobufEnum {
@@ -19,6 +20,16 @@ class ErrorKind extends $pb.ProtobufEnum {
   static const ErrorKind ERROR_KIND_PARSING = ErrorKind._(3, 'ERROR_KIND_PARSING');
   static const ErrorKind ERROR_KIND_VALIDATION = ErrorKind._(4, 'ERROR_KIND_VALIDATION');
   static const ErrorKind ERROR_KIND_INTERNAL = ErrorKind._(5, 'ERROR_KIND_INTERNAL');
+  static const ErrorKind ERROR_KIND_CANCELLED = ErrorKind._(6, 'ERROR_KIND_CANCELLED');
+  static const ErrorKind ERROR_KIND_HTTP_STATUS = ErrorKind._(7, 'ERROR_KIND_HTTP_STATUS');
+  static const ErrorKind ERROR_KIND_DNS = ErrorKind._(8, 'ERROR_KIND_DNS');
+  static const ErrorKind ERROR_KIND_TLS = ErrorKind._(9, 'ERROR_KIND_TLS');
+  static const ErrorKind ERROR_KIND_TIMEOUT = ErrorKind._(10, 'ERROR_KIND_TIMEOUT');
+  static const ErrorKind ERROR_KIND_TOO_MANY_REDIRECTS = ErrorKind._(11, 'ERROR_KIND_TOO_MANY_REDIRECTS');
+  static const ErrorKind ERROR_KIND_RATE_LIMITED = ErrorKind._(12, 'ERROR_KIND_RATE_LIMITED');
+  static const ErrorKind ERROR_KIND_NOT_A_FEED = ErrorKind._(13, 'ERROR_KIND_NOT_A_FEED');
+  static const ErrorKind ERROR_KIND_BODY_TOO_LARGE = ErrorKind._(14, 'ERROR_KIND_BODY_TOO_LARGE');
+  static const ErrorKind ERROR_KIND_BLOCKED_BY_POLICY = ErrorKind._(15, 'ERROR_KIND_BLOCKED_BY_POLICY');
 
   static const $core.List<ErrorKind> values = <ErrorKind>[
     ERROR_KIND_UNKNOWN,
@@ -27,6 +38,16 @@ class ErrorKind extends $pb.ProtobufEnum {
     ERROR_KIND_PARSING,
     ERROR_KIND_VALIDATION,
     ERROR_KIND_INTERNAL,
+    ERROR_KIND_CANCELLED,
+    ERROR_KIND_HTTP_STATUS,
+    ERROR_KIND_DNS,
+    ERROR_KIND_TLS,
+    ERROR_KIND_TIMEOUT,
+    ERROR_KIND_TOO_MANY_REDIRECTS,
+    ERROR_KIND_RATE_LIMITED,
+    ERROR_KIND_NOT_A_FEED,
+    ERROR_KIND_BODY_TOO_LARGE,
+    ERROR_KIND_BLOCKED_BY_POLICY,
   ];
 
   static final $core.Map<$core.int, ErrorKind> _byValue = $pb.ProtobufEnum.initByValue(values);
@@ -52,14 +73,155 @@ class ParseFeedsStatus extends $pb.ProtobufEnum {
   const ParseFeedsStatus._($core.int v, $core.String n) : super(v, n);
 }
 
+class TlsVersion extends $pb.ProtobufEnum {
+  static const TlsVersion TLS_VERSION_DEFAULT = TlsVersion._(0, 'TLS_VERSION_DEFAULT');
+  static const TlsVersion TLS_VERSION_1_2 = TlsVersion._(1, 'TLS_VERSION_1_2');
+  static const TlsVersion TLS_VERSION_1_3 = TlsVersion._(2, 'TLS_VERSION_1_3');
+
+  static const $core.List<TlsVersion> values = <TlsVersion>[
+    TLS_VERSION_DEFAULT,
+    TLS_VERSION_1_2,
+    TLS_VERSION_1_3,
+  ];
+
+  static final $core.Map<$core.int, TlsVersion> _byValue = $pb.ProtobufEnum.initByValue(values);
+  static TlsVersion? valueOf($core.int value) => _byValue[value];
+
+  const TlsVersion._($core.int v, $core.String n) : super(v, n);
+}
+
+class DiscoverySource extends $pb.ProtobufEnum {
+  static const DiscoverySource DISCOVERY_SOURCE_UNKNOWN = DiscoverySource._(0, 'DISCOVERY_SOURCE_UNKNOWN');
+  static const DiscoverySource DISCOVERY_SOURCE_DIRECT = DiscoverySource._(1, 'DISCOVERY_SOURCE_DIRECT');
+  static const DiscoverySource DISCOVERY_SOURCE_LINK_TAG = DiscoverySource._(2, 'DISCOVERY_SOURCE_LINK_TAG');
+  static const DiscoverySource DISCOVERY_SOURCE_WELL_KNOWN_PATH = DiscoverySource._(3, 'DISCOVERY_SOURCE_WELL_KNOWN_PATH');
+
+  static const $core.List<DiscoverySource> values = <DiscoverySource>[
+    DISCOVERY_SOURCE_UNKNOWN,
+    DISCOVERY_SOURCE_DIRECT,
+    DISCOVERY_SOURCE_LINK_TAG,
+    DISCOVERY_SOURCE_WELL_KNOWN_PATH,
+  ];
+
+  static final $core.Map<$core.int, DiscoverySource> _byValue = $pb.ProtobufEnum.initByValue(values);
+  static DiscoverySource? valueOf($core.int value) => _byValue[value];
+
+  const DiscoverySource._($core.int v, $core.String n) : super(v, n);
+}
+
+class ContentFormat extends $pb.ProtobufEnum {
+  static const ContentFormat CONTENT_FORMAT_DEFAULT = ContentFormat._(0, 'CONTENT_FORMAT_DEFAULT');
+  static const ContentFormat CONTENT_FORMAT_PLAIN_TEXT = ContentFormat._(1, 'CONTENT_FORMAT_PLAIN_TEXT');
+  static const ContentFormat CONTENT_FORMAT_HTML = ContentFormat._(2, 'CONTENT_FORMAT_HTML');
+
+  static const $core.List<ContentFormat> values = <ContentFormat>[
+    CONTENT_FORMAT_DEFAULT,
+    CONTENT_FORMAT_PLAIN_TEXT,
+    CONTENT_FORMAT_HTML,
+  ];
+
+  static final $core.Map<$core.int, ContentFormat> _byValue = $pb.ProtobufEnum.initByValue(values);
+  static ContentFormat? valueOf($core.int value) => _byValue[value];
+
+  const ContentFormat._($core.int v, $core.String n) : super(v, n);
+}
+
+class ImageSource extends $pb.ProtobufEnum {
+  static const ImageSource IMAGE_SOURCE_NONE = ImageSource._(0, 'IMAGE_SOURCE_NONE');
+  static const ImageSource IMAGE_SOURCE_FEED = ImageSource._(1, 'IMAGE_SOURCE_FEED');
+  static const ImageSource IMAGE_SOURCE_PODCAST = ImageSource._(2, 'IMAGE_SOURCE_PODCAST');
+  static const ImageSource IMAGE_SOURCE_MEDIA = ImageSource._(3, 'IMAGE_SOURCE_MEDIA');
+  static const ImageSource IMAGE_SOURCE_ENCLOSURE = ImageSource._(4, 'IMAGE_SOURCE_ENCLOSURE');
+  static const ImageSource IMAGE_SOURCE_CONTENT = ImageSource._(5, 'IMAGE_SOURCE_CONTENT');
+  static const ImageSource IMAGE_SOURCE_OPEN_GRAPH = ImageSource._(6, 'IMAGE_SOURCE_OPEN_GRAPH');
+
+  static const $core.List<ImageSource> values = <ImageSource>[
+    IMAGE_SOURCE_NONE,
+    IMAGE_SOURCE_FEED,
+    IMAGE_SOURCE_PODCAST,
+    IMAGE_SOURCE_MEDIA,
+    IMAGE_SOURCE_ENCLOSURE,
+    IMAGE_SOURCE_CONTENT,
+    IMAGE_SOURCE_OPEN_GRAPH,
+  ];
+
+  static final $core.Map<$core.int, ImageSource> _byValue = $pb.ProtobufEnum.initByValue(values);
+  static ImageSource? valueOf($core.int value) => _byValue[value];
+
+  const ImageSource._($core.int v, $core.String n) : super(v, n);
+}
+
+class IconSource extends $pb.ProtobufEnum {
+  static const IconSource ICON_SOURCE_UNKNOWN = IconSource._(0, 'ICON_SOURCE_UNKNOWN');
+  static const IconSource ICON_SOURCE_FEED = IconSource._(1, 'ICON_SOURCE_FEED');
+  static const IconSource ICON_SOURCE_LINK_ICON = IconSource._(2, 'ICON_SOURCE_LINK_ICON');
+  static const IconSource ICON_SOURCE_APPLE_TOUCH_ICON = IconSource._(3, 'ICON_SOURCE_APPLE_TOUCH_ICON');
+  static const IconSource ICON_SOURCE_FAVICON = IconSource._(4, 'ICON_SOURCE_FAVICON');
+
+  static const $core.List<IconSource> values = <IconSource>[
+    ICON_SOURCE_UNKNOWN,
+    ICON_SOURCE_FEED,
+    ICON_SOURCE_LINK_ICON,
+    ICON_SOURCE_APPLE_TOUCH_ICON,
+    ICON_SOURCE_FAVICON,
+  ];
+
+  static final $core.Map<$core.int, IconSource> _byValue = $pb.ProtobufEnum.initByValue(values);
+  static IconSource? valueOf($core.int value) => _byValue[value];
+
+  const IconSource._($core.int v, $core.String n) : super(v, n);
+}
+
+class CacheAction extends $pb.ProtobufEnum {
+  static const CacheAction CACHE_ACTION_INSPECT = CacheAction._(0, 'CACHE_ACTION_INSPECT');
+  static const CacheAction CACHE_ACTION_CLEAR = CacheAction._(1, 'CACHE_ACTION_CLEAR');
+
+  static const $core.List<CacheAction> values = <CacheAction>[
+    CACHE_ACTION_INSPECT,
+    CACHE_ACTION_CLEAR,
+  ];
+
+  static final $core.Map<$core.int, CacheAction> _byValue = $pb.ProtobufEnum.initByValue(values);
+  static CacheAction? valueOf($core.int value) => _byValue[value];
+
+  const CacheAction._($core.int v, $core.String n) : super(v, n);
+}
+
+class HttpCacheStatus extends $pb.ProtobufEnum {
+  static const HttpCacheStatus HTTP_CACHE_STATUS_NONE = HttpCacheStatus._(0, 'HTTP_CACHE_STATUS_NONE');
+  static const HttpCacheStatus HTTP_CACHE_STATUS_MISS = HttpCacheStatus._(1, 'HTTP_CACHE_STATUS_MISS');
+  static const HttpCacheStatus HTTP_CACHE_STATUS_HIT = HttpCacheStatus._(2, 'HTTP_CACHE_STATUS_HIT');
+  static const HttpCacheStatus HTTP_CACHE_STATUS_REVALIDATED = HttpCacheStatus._(3, 'HTTP_CACHE_STATUS_REVALIDATED');
+
+  static const $core.List<HttpCacheStatus> values = <HttpCacheStatus>[
+    HTTP_CACHE_STATUS_NONE,
+    HTTP_CACHE_STATUS_MISS,
+    HTTP_CACHE_STATUS_HIT,
+    HTTP_CACHE_STATUS_REVALIDATED,
+  ];
+
+  static final $core.Map<$core.int, HttpCacheStatus> _byValue = $pb.ProtobufEnum.initByValue(values);
+  static HttpCacheStatus? valueOf($core.int value) => _byValue[value];
+
+  const HttpCacheStatus._($core.int v, $core.String n) : super(v, n);
+}
+
 class ValidateFeedRequest extends $pb.GeneratedMessage {
   factory ValidateFeedRequest({
     $core.String? url,
+    $core.String? requestId,
+    $fixnum.Int64? timeoutMs,
   }) {
     final $result = create();
     if (url != null) {
       $result.url = url;
     }
+    if (requestId != null) {
+      $result.requestId = requestId;
+    }
+    if (timeoutMs != null) {
+      $result.timeoutMs = timeoutMs;
+    }
     return $result;
   }
   ValidateFeedRequest._() : super();
@@ -74,6 +236,8 @@ class ValidateFeedRequest extends $pb.GeneratedMessage {
     createEmptyInstance: create,
   )
     ..aOS(1, 'url')
+    ..aOS(2, 'requestId')
+    ..aInt64(3, 'timeoutMs')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
@@ -103,6 +267,30 @@ class ValidateFeedRequest extends $pb.GeneratedMessage {
   $core.bool hasUrl() => $_has(0);
   @$pb.TagNumber(1)
   void clearUrl() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get requestId => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set requestId($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasRequestId() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearRequestId() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get timeoutMs => $_getI64(2);
+  @$pb.TagNumber(3)
+  set timeoutMs($fixnum.Int64 v) {
+    $_setInt64(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasTimeoutMs() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearTimeoutMs() => clearField(3);
 }
 
 class ErrorDetail extends $pb.GeneratedMessage {
@@ -110,6 +298,13 @@ class ErrorDetail extends $pb.GeneratedMessage {
     ErrorKind? kind,
     $core.String? message,
     $core.String? url,
+    FetchDiagnostics? diagnostics,
+    $core.int? httpStatus,
+    $fixnum.Int64? retryAfterMs,
+    $core.bool? retryable,
+    $core.String? canonicalUrl,
+    $core.Iterable<$core.String>? mergedUrls,
+    $core.int? inputIndex,
   }) {
     final $result = create();
     if (kind != null) {
@@ -121,6 +316,27 @@ class ErrorDetail extends $pb.GeneratedMessage {
     if (url != null) {
       $result.url = url;
     }
+    if (diagnostics != null) {
+      $result.diagnostics = diagnostics;
+    }
+    if (httpStatus != null) {
+      $result.httpStatus = httpStatus;
+    }
+    if (retryAfterMs != null) {
+      $result.retryAfterMs = retryAfterMs;
+    }
+    if (retryable != null) {
+      $result.retryable = retryable;
+    }
+    if (canonicalUrl != null) {
+      $result.canonicalUrl = canonicalUrl;
+    }
+    if (mergedUrls != null) {
+      $result.mergedUrls.addAll(mergedUrls);
+    }
+    if (inputIndex != null) {
+      $result.inputIndex = inputIndex;
+    }
     return $result;
   }
   ErrorDetail._() : super();
@@ -144,6 +360,13 @@ class ErrorDetail extends $pb.GeneratedMessage {
     )
     ..aOS(2, 'message')
     ..aOS(3, 'url')
+    ..aOM<FetchDiagnostics>(4, 'diagnostics', subBuilder: FetchDiagnostics.create)
+    ..a<$core.int>(5, 'httpStatus', $pb.PbFieldType.O3)
+    ..aInt64(6, 'retryAfterMs')
+    ..aOB(7, 'retryable')
+    ..aOS(8, 'canonicalUrl')
+    ..pPS(9, 'mergedUrls')
+    ..a<$core.int>(10, 'inputIndex', $pb.PbFieldType.O3)
     ..hasRequiredFields = false;
 
   @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
@@ -197,12 +420,90 @@ class ErrorDetail extends $pb.GeneratedMessage {
   $core.bool hasUrl() => $_has(2);
   @$pb.TagNumber(3)
   void clearUrl() => clearField(3);
+
+  @$pb.TagNumber(4)
+  FetchDiagnostics get diagnostics => $_getN(3);
+  @$pb.TagNumber(4)
+  set diagnostics(FetchDiagnostics v) {
+    setField(4, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasDiagnostics() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearDiagnostics() => clearField(4);
+  @$pb.TagNumber(4)
+  FetchDiagnostics ensureDiagnostics() => $_ensure(3);
+
+  @$pb.TagNumber(5)
+  $core.int get httpStatus => $_getIZ(4);
+  @$pb.TagNumber(5)
+  set httpStatus($core.int v) {
+    $_setSignedInt32(4, v);
+  }
+
+  @$pb.TagNumber(5)
+  $core.bool hasHttpStatus() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearHttpStatus() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $fixnum.Int64 get retryAfterMs => $_getI64(5);
+  @$pb.TagNumber(6)
+  set retryAfterMs($fixnum.Int64 v) {
+    $_setInt64(5, v);
+  }
+
+  @$pb.TagNumber(6)
+  $core.bool hasRetryAfterMs() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearRetryAfterMs() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.bool get retryable => $_getBF(6);
+  @$pb.TagNumber(7)
+  set retryable($core.bool v) {
+    $_setBool(6, v);
+  }
+
+  @$pb.TagNumber(7)
+  $core.bool hasRetryable() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearRetryable() => clearField(7);
+
+  @$pb.TagNumber(8)
+  $core.String get canonicalUrl => $_getSZ(7);
+  @$pb.TagNumber(8)
+  set canonicalUrl($core.String v) {
+    $_setString(7, v);
+  }
+
+  @$pb.TagNumber(8)
+  $core.bool hasCanonicalUrl() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearCanonicalUrl() => clearField(8);
+
+  @$pb.TagNumber(9)
+  $core.List<$core.String> get mergedUrls => $_getList(8);
+
+  @$pb.TagNumber(10)
+  $core.int get inputIndex => $_getIZ(9);
+  @$pb.TagNumber(10)
+  set inputIndex($core.int v) {
+    $_setSignedInt32(9, v);
+  }
+
+  @$pb.TagNumber(10)
+  $core.bool hasInputIndex() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearInputIndex() => clearField(10);
 }
 
 class ValidateFeedResponse extends $pb.GeneratedMessage {
   factory ValidateFeedResponse({
     $core.bool? valid,
     ErrorDetail? error,
+    $core.String? canonicalUrl,
   }) {
     final $result = create();
     if (valid != null) {
@@ -211,6 +512,9 @@ class ValidateFeedResponse extends $pb.GeneratedMessage {
     if (error != null) {
       $result.error = error;
     }
+    if (canonicalUrl != null) {
+      $result.canonicalUrl = canonicalUrl;
+    }
     return $result;
   }
   ValidateFeedResponse._() : super();
@@ -226,6 +530,7 @@ class ValidateFeedResponse extends $pb.GeneratedMessage {
   )
     ..aOB(1, 'valid')
     ..aOM<ErrorDetail>(2, 'error', subBuilder: ErrorDetail.create)
+    ..aOS(3, 'canonicalUrl')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
@@ -270,16 +575,80 @@ class ValidateFeedResponse extends $pb.GeneratedMessage {
   void clearError() => clearField(2);
   @$pb.TagNumber(2)
   ErrorDetail ensureError() => $_ensure(1);
+
+  @$pb.TagNumber(3)
+  $core.String get canonicalUrl => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set canonicalUrl($core.String v) {
+    $_setString(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasCanonicalUrl() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearCanonicalUrl() => clearField(3);
 }
 
 class ParseFeedsRequest extends $pb.GeneratedMessage {
   factory ParseFeedsRequest({
     $core.Iterable<$core.String>? urls,
+    $core.Iterable<FeedValidators>? validators,
+    $core.String? requestId,
+    $fixnum.Int64? timeoutMs,
+    $fixnum.Int64? feedTimeoutMs,
+    $core.int? maxConcurrency,
+    RetryPolicy? retry,
+    ContentFormat? contentFormat,
+    $core.bool? fetchOgImage,
+    $core.bool? bypassCache,
+    $core.bool? refreshCache,
+    FeedLimits? limits,
+    $core.bool? stripTrackingParams,
+    $core.bool? preserveOrder,
   }) {
     final $result = create();
     if (urls != null) {
       $result.urls.addAll(urls);
     }
+    if (validators != null) {
+      $result.validators.addAll(validators);
+    }
+    if (requestId != null) {
+      $result.requestId = requestId;
+    }
+    if (timeoutMs != null) {
+      $result.timeoutMs = timeoutMs;
+    }
+    if (feedTimeoutMs != null) {
+      $result.feedTimeoutMs = feedTimeoutMs;
+    }
+    if (maxConcurrency != null) {
+      $result.maxConcurrency = maxConcurrency;
+    }
+    if (retry != null) {
+      $result.retry = retry;
+    }
+    if (contentFormat != null) {
+      $result.contentFormat = contentFormat;
+    }
+    if (fetchOgImage != null) {
+      $result.fetchOgImage = fetchOgImage;
+    }
+    if (bypassCache != null) {
+      $result.bypassCache = bypassCache;
+    }
+    if (refreshCache != null) {
+      $result.refreshCache = refreshCache;
+    }
+    if (limits != null) {
+      $result.limits = limits;
+    }
+    if (stripTrackingParams != null) {
+      $result.stripTrackingParams = stripTrackingParams;
+    }
+    if (preserveOrder != null) {
+      $result.preserveOrder = preserveOrder;
+    }
     return $result;
   }
   ParseFeedsRequest._() : super();
@@ -294,6 +663,26 @@ class ParseFeedsRequest extends $pb.GeneratedMessage {
     createEmptyInstance: create,
   )
     ..pPS(1, 'urls')
+    ..pc<FeedValidators>(2, 'validators', $pb.PbFieldType.PM, subBuilder: FeedValidators.create)
+    ..aOS(3, 'requestId')
+    ..aInt64(4, 'timeoutMs')
+    ..aInt64(5, 'feedTimeoutMs')
+    ..a<$core.int>(6, 'maxConcurrency', $pb.PbFieldType.O3)
+    ..aOM<RetryPolicy>(7, 'retry', subBuilder: RetryPolicy.create)
+    ..e<ContentFormat>(
+      8,
+      'contentFormat',
+      $pb.PbFieldType.OE,
+      defaultOrMaker: ContentFormat.CONTENT_FORMAT_DEFAULT,
+      valueOf: ContentFormat.valueOf,
+      enumValues: ContentFormat.values,
+    )
+    ..aOB(9, 'fetchOgImage')
+    ..aOB(10, 'bypassCache')
+    ..aOB(11, 'refreshCache')
+    ..aOM<FeedLimits>(12, 'limits', subBuilder: FeedLimits.create)
+    ..aOB(13, 'stripTrackingParams')
+    ..aOB(14, 'preserveOrder')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
@@ -315,6 +704,157 @@ class ParseFeedsRequest extends $pb.GeneratedMessage {
 
   @$pb.TagNumber(1)
   $core.List<$core.String> get urls => $_getList(0);
+
+  @$pb.TagNumber(2)
+  $core.List<FeedValidators> get validators => $_getList(1);
+
+  @$pb.TagNumber(3)
+  $core.String get requestId => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set requestId($core.String v) {
+    $_setString(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasRequestId() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearRequestId() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get timeoutMs => $_getI64(3);
+  @$pb.TagNumber(4)
+  set timeoutMs($fixnum.Int64 v) {
+    $_setInt64(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasTimeoutMs() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearTimeoutMs() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get feedTimeoutMs => $_getI64(4);
+  @$pb.TagNumber(5)
+  set feedTimeoutMs($fixnum.Int64 v) {
+    $_setInt64(4, v);
+  }
+
+  @$pb.TagNumber(5)
+  $core.bool hasFeedTimeoutMs() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearFeedTimeoutMs() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.int get maxConcurrency => $_getIZ(5);
+  @$pb.TagNumber(6)
+  set maxConcurrency($core.int v) {
+    $_setSignedInt32(5, v);
+  }
+
+  @$pb.TagNumber(6)
+  $core.bool hasMaxConcurrency() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearMaxConcurrency() => clearField(6);
+
+  @$pb.TagNumber(7)
+  RetryPolicy get retry => $_getN(6);
+  @$pb.TagNumber(7)
+  set retry(RetryPolicy v) {
+    setField(7, v);
+  }
+
+  @$pb.TagNumber(7)
+  $core.bool hasRetry() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearRetry() => clearField(7);
+  @$pb.TagNumber(7)
+  RetryPolicy ensureRetry() => $_ensure(6);
+
+  @$pb.TagNumber(8)
+  ContentFormat get contentFormat => $_getN(7);
+  @$pb.TagNumber(8)
+  set contentFormat(ContentFormat v) {
+    setField(8, v);
+  }
+
+  @$pb.TagNumber(8)
+  $core.bool hasContentFormat() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearContentFormat() => clearField(8);
+
+  @$pb.TagNumber(9)
+  $core.bool get fetchOgImage => $_getBF(8);
+  @$pb.TagNumber(9)
+  set fetchOgImage($core.bool v) {
+    $_setBool(8, v);
+  }
+
+  @$pb.TagNumber(9)
+  $core.bool hasFetchOgImage() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearFetchOgImage() => clearField(9);
+
+  @$pb.TagNumber(10)
+  $core.bool get bypassCache => $_getBF(9);
+  @$pb.TagNumber(10)
+  set bypassCache($core.bool v) {
+    $_setBool(9, v);
+  }
+
+  @$pb.TagNumber(10)
+  $core.bool hasBypassCache() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearBypassCache() => clearField(10);
+
+  @$pb.TagNumber(11)
+  $core.bool get refreshCache => $_getBF(10);
+  @$pb.TagNumber(11)
+  set refreshCache($core.bool v) {
+    $_setBool(10, v);
+  }
+
+  @$pb.TagNumber(11)
+  $core.bool hasRefreshCache() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearRefreshCache() => clearField(11);
+
+  @$pb.TagNumber(12)
+  FeedLimits get limits => $_getN(11);
+  @$pb.TagNumber(12)
+  set limits(FeedLimits v) {
+    setField(12, v);
+  }
+
+  @$pb.TagNumber(12)
+  $core.bool hasLimits() => $_has(11);
+  @$pb.TagNumber(12)
+  void clearLimits() => clearField(12);
+  @$pb.TagNumber(12)
+  FeedLimits ensureLimits() => $_ensure(11);
+
+  @$pb.TagNumber(13)
+  $core.bool get stripTrackingParams => $_getBF(12);
+  @$pb.TagNumber(13)
+  set stripTrackingParams($core.bool v) {
+    $_setBool(12, v);
+  }
+
+  @$pb.TagNumber(13)
+  $core.bool hasStripTrackingParams() => $_has(12);
+  @$pb.TagNumber(13)
+  void clearStripTrackingParams() => clearField(13);
+
+  @$pb.TagNumber(14)
+  $core.bool get preserveOrder => $_getBF(13);
+  @$pb.TagNumber(14)
+  set preserveOrder($core.bool v) {
+    $_setBool(13, v);
+  }
+
+  @$pb.TagNumber(14)
+  $core.bool hasPreserveOrder() => $_has(13);
+  @$pb.TagNumber(14)
+  void clearPreserveOrder() => clearField(14);
 }
 
 class FeedItem extends $pb.GeneratedMessage {
@@ -324,6 +864,15 @@ class FeedItem extends $pb.GeneratedMessage {
     $core.String? link,
     $core.String? image,
     $core.String? published,
+    $core.Iterable<Enclosure>? enclosures,
+    PodcastEpisode? podcast,
+    $core.String? id,
+    $core.String? guid,
+    $core.String? contentHash,
+    JsonFeedItem? jsonFeed,
+    $core.String? content,
+    ImageSource? imageSource,
+    $core.bool? truncated,
   }) {
     final $result = create();
     if (title != null) {
@@ -341,6 +890,33 @@ class FeedItem extends $pb.GeneratedMessage {
     if (published != null) {
       $result.published = published;
     }
+    if (enclosures != null) {
+      $result.enclosures.addAll(enclosures);
+    }
+    if (podcast != null) {
+      $result.podcast = podcast;
+    }
+    if (id != null) {
+      $result.id = id;
+    }
+    if (guid != null) {
+      $result.guid = guid;
+    }
+    if (contentHash != null) {
+      $result.contentHash = contentHash;
+    }
+    if (jsonFeed != null) {
+      $result.jsonFeed = jsonFeed;
+    }
+    if (content != null) {
+      $result.content = content;
+    }
+    if (imageSource != null) {
+      $result.imageSource = imageSource;
+    }
+    if (truncated != null) {
+      $result.truncated = truncated;
+    }
     return $result;
   }
   FeedItem._() : super();
@@ -359,6 +935,22 @@ class FeedItem extends $pb.GeneratedMessage {
     ..aOS(3, 'link')
     ..aOS(4, 'image')
     ..aOS(5, 'published')
+    ..pc<Enclosure>(6, 'enclosures', $pb.PbFieldType.PM, subBuilder: Enclosure.create)
+    ..aOM<PodcastEpisode>(7, 'podcast', subBuilder: PodcastEpisode.create)
+    ..aOS(8, 'id')
+    ..aOS(9, 'guid')
+    ..aOS(10, 'contentHash')
+    ..aOM<JsonFeedItem>(11, 'jsonFeed', subBuilder: JsonFeedItem.create)
+    ..aOS(12, 'content')
+    ..e<ImageSource>(
+      13,
+      'imageSource',
+      $pb.PbFieldType.OE,
+      defaultOrMaker: ImageSource.IMAGE_SOURCE_NONE,
+      valueOf: ImageSource.valueOf,
+      enumValues: ImageSource.values,
+    )
+    ..aOB(14, 'truncated')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
@@ -436,6 +1028,109 @@ class FeedItem extends $pb.GeneratedMessage {
   $core.bool hasPublished() => $_has(4);
   @$pb.TagNumber(5)
   void clearPublished() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.List<Enclosure> get enclosures => $_getList(5);
+
+  @$pb.TagNumber(7)
+  PodcastEpisode get podcast => $_getN(6);
+  @$pb.TagNumber(7)
+  set podcast(PodcastEpisode v) {
+    setField(7, v);
+  }
+
+  @$pb.TagNumber(7)
+  $core.bool hasPodcast() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearPodcast() => clearField(7);
+  @$pb.TagNumber(7)
+  PodcastEpisode ensurePodcast() => $_ensure(6);
+
+  @$pb.TagNumber(8)
+  $core.String get id => $_getSZ(7);
+  @$pb.TagNumber(8)
+  set id($core.String v) {
+    $_setString(7, v);
+  }
+
+  @$pb.TagNumber(8)
+  $core.bool hasId() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearId() => clearField(8);
+
+  @$pb.TagNumber(9)
+  $core.String get guid => $_getSZ(8);
+  @$pb.TagNumber(9)
+  set guid($core.String v) {
+    $_setString(8, v);
+  }
+
+  @$pb.TagNumber(9)
+  $core.bool hasGuid() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearGuid() => clearField(9);
+
+  @$pb.TagNumber(10)
+  $core.String get contentHash => $_getSZ(9);
+  @$pb.TagNumber(10)
+  set contentHash($core.String v) {
+    $_setString(9, v);
+  }
+
+  @$pb.TagNumber(10)
+  $core.bool hasContentHash() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearContentHash() => clearField(10);
+
+  @$pb.TagNumber(11)
+  JsonFeedItem get jsonFeed => $_getN(10);
+  @$pb.TagNumber(11)
+  set jsonFeed(JsonFeedItem v) {
+    setField(11, v);
+  }
+
+  @$pb.TagNumber(11)
+  $core.bool hasJsonFeed() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearJsonFeed() => clearField(11);
+  @$pb.TagNumber(11)
+  JsonFeedItem ensureJsonFeed() => $_ensure(10);
+
+  @$pb.TagNumber(12)
+  $core.String get content => $_getSZ(11);
+  @$pb.TagNumber(12)
+  set content($core.String v) {
+    $_setString(11, v);
+  }
+
+  @$pb.TagNumber(12)
+  $core.bool hasContent() => $_has(11);
+  @$pb.TagNumber(12)
+  void clearContent() => clearField(12);
+
+  @$pb.TagNumber(13)
+  ImageSource get imageSource => $_getN(12);
+  @$pb.TagNumber(13)
+  set imageSource(ImageSource v) {
+    setField(13, v);
+  }
+
+  @$pb.TagNumber(13)
+  $core.bool hasImageSource() => $_has(12);
+  @$pb.TagNumber(13)
+  void clearImageSource() => clearField(13);
+
+  @$pb.TagNumber(14)
+  $core.bool get truncated => $_getBF(13);
+  @$pb.TagNumber(14)
+  set truncated($core.bool v) {
+    $_setBool(13, v);
+  }
+
+  @$pb.TagNumber(14)
+  $core.bool hasTruncated() => $_has(13);
+  @$pb.TagNumber(14)
+  void clearTruncated() => clearField(14);
 }
 
 class Feed extends $pb.GeneratedMessage {
@@ -445,6 +1140,19 @@ class Feed extends $pb.GeneratedMessage {
     $core.String? description,
     $core.String? image,
     $core.Iterable<FeedItem>? items,
+    $core.String? etag,
+    $core.String? lastModified,
+    $core.bool? notModified,
+    $core.String? link,
+    PodcastChannel? podcast,
+    FetchDiagnostics? diagnostics,
+    JsonFeedChannel? jsonFeed,
+    $core.bool? cached,
+    $fixnum.Int64? cacheAgeMs,
+    $core.bool? truncated,
+    $core.String? canonicalUrl,
+    $core.Iterable<$core.String>? mergedUrls,
+    $core.int? inputIndex,
   }) {
     final $result = create();
     if (url != null) {
@@ -462,6 +1170,45 @@ class Feed extends $pb.GeneratedMessage {
     if (items != null) {
       $result.items.addAll(items);
     }
+    if (etag != null) {
+      $result.etag = etag;
+    }
+    if (lastModified != null) {
+      $result.lastModified = lastModified;
+    }
+    if (notModified != null) {
+      $result.notModified = notModified;
+    }
+    if (link != null) {
+      $result.link = link;
+    }
+    if (podcast != null) {
+      $result.podcast = podcast;
+    }
+    if (diagnostics != null) {
+      $result.diagnostics = diagnostics;
+    }
+    if (jsonFeed != null) {
+      $result.jsonFeed = jsonFeed;
+    }
+    if (cached != null) {
+      $result.cached = cached;
+    }
+    if (cacheAgeMs != null) {
+      $result.cacheAgeMs = cacheAgeMs;
+    }
+    if (truncated != null) {
+      $result.truncated = truncated;
+    }
+    if (canonicalUrl != null) {
+      $result.canonicalUrl = canonicalUrl;
+    }
+    if (mergedUrls != null) {
+      $result.mergedUrls.addAll(mergedUrls);
+    }
+    if (inputIndex != null) {
+      $result.inputIndex = inputIndex;
+    }
     return $result;
   }
   Feed._() : super();
@@ -480,6 +1227,19 @@ class Feed extends $pb.GeneratedMessage {
     ..aOS(3, 'description')
     ..aOS(5, 'image')
     ..pc<FeedItem>(6, 'items', $pb.PbFieldType.PM, subBuilder: FeedItem.create)
+    ..aOS(7, 'etag')
+    ..aOS(8, 'lastModified')
+    ..aOB(9, 'notModified')
+    ..aOS(10, 'link')
+    ..aOM<PodcastChannel>(11, 'podcast', subBuilder: PodcastChannel.create)
+    ..aOM<FetchDiagnostics>(12, 'diagnostics', subBuilder: FetchDiagnostics.create)
+    ..aOM<JsonFeedChannel>(13, 'jsonFeed', subBuilder: JsonFeedChannel.create)
+    ..aOB(14, 'cached')
+    ..aInt64(15, 'cacheAgeMs')
+    ..aOB(16, 'truncated')
+    ..aOS(17, 'canonicalUrl')
+    ..pPS(18, 'mergedUrls')
+    ..a<$core.int>(19, 'inputIndex', $pb.PbFieldType.O3)
     ..hasRequiredFields = false;
 
   @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
@@ -547,33 +1307,186 @@ class Feed extends $pb.GeneratedMessage {
 
   @$pb.TagNumber(6)
   $core.List<FeedItem> get items => $_getList(4);
-}
 
-class ParseFeedsResponse extends $pb.GeneratedMessage {
-  factory ParseFeedsResponse({
-    ParseFeedsStatus? status,
-    $core.Iterable<Feed>? feeds,
-    $core.Iterable<ErrorDetail>? errors,
-    ErrorDetail? fatalError,
-  }) {
-    final $result = create();
-    if (status != null) {
-      $result.status = status;
-    }
-    if (feeds != null) {
-      $result.feeds.addAll(feeds);
-    }
-    if (errors != null) {
-      $result.errors.addAll(errors);
-    }
-    if (fatalError != null) {
-      $result.fatalError = fatalError;
-    }
-    return $result;
+  @$pb.TagNumber(7)
+  $core.String get etag => $_getSZ(5);
+  @$pb.TagNumber(7)
+  set etag($core.String v) {
+    $_setString(5, v);
   }
-  ParseFeedsResponse._() : super();
-  factory ParseFeedsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
-      create()..mergeFromBuffer(i, r);
+
+  @$pb.TagNumber(7)
+  $core.bool hasEtag() => $_has(5);
+  @$pb.TagNumber(7)
+  void clearEtag() => clearField(7);
+
+  @$pb.TagNumber(8)
+  $core.String get lastModified => $_getSZ(6);
+  @$pb.TagNumber(8)
+  set lastModified($core.String v) {
+    $_setString(6, v);
+  }
+
+  @$pb.TagNumber(8)
+  $core.bool hasLastModified() => $_has(6);
+  @$pb.TagNumber(8)
+  void clearLastModified() => clearField(8);
+
+  @$pb.TagNumber(9)
+  $core.bool get notModified => $_getBF(7);
+  @$pb.TagNumber(9)
+  set notModified($core.bool v) {
+    $_setBool(7, v);
+  }
+
+  @$pb.TagNumber(9)
+  $core.bool hasNotModified() => $_has(7);
+  @$pb.TagNumber(9)
+  void clearNotModified() => clearField(9);
+
+  @$pb.TagNumber(10)
+  $core.String get link => $_getSZ(8);
+  @$pb.TagNumber(10)
+  set link($core.String v) {
+    $_setString(8, v);
+  }
+
+  @$pb.TagNumber(10)
+  $core.bool hasLink() => $_has(8);
+  @$pb.TagNumber(10)
+  void clearLink() => clearField(10);
+
+  @$pb.TagNumber(11)
+  PodcastChannel get podcast => $_getN(9);
+  @$pb.TagNumber(11)
+  set podcast(PodcastChannel v) {
+    setField(11, v);
+  }
+
+  @$pb.TagNumber(11)
+  $core.bool hasPodcast() => $_has(9);
+  @$pb.TagNumber(11)
+  void clearPodcast() => clearField(11);
+  @$pb.TagNumber(11)
+  PodcastChannel ensurePodcast() => $_ensure(9);
+
+  @$pb.TagNumber(12)
+  FetchDiagnostics get diagnostics => $_getN(10);
+  @$pb.TagNumber(12)
+  set diagnostics(FetchDiagnostics v) {
+    setField(12, v);
+  }
+
+  @$pb.TagNumber(12)
+  $core.bool hasDiagnostics() => $_has(10);
+  @$pb.TagNumber(12)
+  void clearDiagnostics() => clearField(12);
+  @$pb.TagNumber(12)
+  FetchDiagnostics ensureDiagnostics() => $_ensure(10);
+
+  @$pb.TagNumber(13)
+  JsonFeedChannel get jsonFeed => $_getN(11);
+  @$pb.TagNumber(13)
+  set jsonFeed(JsonFeedChannel v) {
+    setField(13, v);
+  }
+
+  @$pb.TagNumber(13)
+  $core.bool hasJsonFeed() => $_has(11);
+  @$pb.TagNumber(13)
+  void clearJsonFeed() => clearField(13);
+  @$pb.TagNumber(13)
+  JsonFeedChannel ensureJsonFeed() => $_ensure(11);
+
+  @$pb.TagNumber(14)
+  $core.bool get cached => $_getBF(12);
+  @$pb.TagNumber(14)
+  set cached($core.bool v) {
+    $_setBool(12, v);
+  }
+
+  @$pb.TagNumber(14)
+  $core.bool hasCached() => $_has(12);
+  @$pb.TagNumber(14)
+  void clearCached() => clearField(14);
+
+  @$pb.TagNumber(15)
+  $fixnum.Int64 get cacheAgeMs => $_getI64(13);
+  @$pb.TagNumber(15)
+  set cacheAgeMs($fixnum.Int64 v) {
+    $_setInt64(13, v);
+  }
+
+  @$pb.TagNumber(15)
+  $core.bool hasCacheAgeMs() => $_has(13);
+  @$pb.TagNumber(15)
+  void clearCacheAgeMs() => clearField(15);
+
+  @$pb.TagNumber(16)
+  $core.bool get truncated => $_getBF(14);
+  @$pb.TagNumber(16)
+  set truncated($core.bool v) {
+    $_setBool(14, v);
+  }
+
+  @$pb.TagNumber(16)
+  $core.bool hasTruncated() => $_has(14);
+  @$pb.TagNumber(16)
+  void clearTruncated() => clearField(16);
+
+  @$pb.TagNumber(17)
+  $core.String get canonicalUrl => $_getSZ(15);
+  @$pb.TagNumber(17)
+  set canonicalUrl($core.String v) {
+    $_setString(15, v);
+  }
+
+  @$pb.TagNumber(17)
+  $core.bool hasCanonicalUrl() => $_has(15);
+  @$pb.TagNumber(17)
+  void clearCanonicalUrl() => clearField(17);
+
+  @$pb.TagNumber(18)
+  $core.List<$core.String> get mergedUrls => $_getList(16);
+
+  @$pb.TagNumber(19)
+  $core.int get inputIndex => $_getIZ(17);
+  @$pb.TagNumber(19)
+  set inputIndex($core.int v) {
+    $_setSignedInt32(17, v);
+  }
+
+  @$pb.TagNumber(19)
+  $core.bool hasInputIndex() => $_has(17);
+  @$pb.TagNumber(19)
+  void clearInputIndex() => clearField(19);
+}
+
+class ParseFeedsResponse extends $pb.GeneratedMessage {
+  factory ParseFeedsResponse({
+    ParseFeedsStatus? status,
+    $core.Iterable<Feed>? feeds,
+    $core.Iterable<ErrorDetail>? errors,
+    ErrorDetail? fatalError,
+  }) {
+    final $result = create();
+    if (status != null) {
+      $result.status = status;
+    }
+    if (feeds != null) {
+      $result.feeds.addAll(feeds);
+    }
+    if (errors != null) {
+      $result.errors.addAll(errors);
+    }
+    if (fatalError != null) {
+      $result.fatalError = fatalError;
+    }
+    return $result;
+  }
+  ParseFeedsResponse._() : super();
+  factory ParseFeedsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
   factory ParseFeedsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
       create()..mergeFromJson(i, r);
 
@@ -644,3 +1557,3902 @@ class ParseFeedsResponse extends $pb.GeneratedMessage {
   @$pb.TagNumber(4)
   ErrorDetail ensureFatalError() => $_ensure(3);
 }
+
+class FeedValidators extends $pb.GeneratedMessage {
+  factory FeedValidators({
+    $core.String? url,
+    $core.String? etag,
+    $core.String? lastModified,
+  }) {
+    final $result = create();
+    if (url != null) {
+      $result.url = url;
+    }
+    if (etag != null) {
+      $result.etag = etag;
+    }
+    if (lastModified != null) {
+      $result.lastModified = lastModified;
+    }
+    return $result;
+  }
+  FeedValidators._() : super();
+  factory FeedValidators.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory FeedValidators.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'FeedValidators',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'url')
+    ..aOS(2, 'etag')
+    ..aOS(3, 'lastModified')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  FeedValidators clone() => FeedValidators()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  FeedValidators copyWith(void Function(FeedValidators) updates) =>
+      super.copyWith((message) => updates(message as FeedValidators)) as FeedValidators;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static FeedValidators create() => FeedValidators._();
+  FeedValidators createEmptyInstance() => create();
+  static $pb.PbList<FeedValidators> createRepeated() => $pb.PbList<FeedValidators>();
+  @$core.pragma('dart2js:noInline')
+  static FeedValidators getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<FeedValidators>(create);
+  static FeedValidators? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get url => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set url($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasUrl() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUrl() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get etag => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set etag($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasEtag() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearEtag() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get lastModified => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set lastModified($core.String v) {
+    $_setString(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasLastModified() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearLastModified() => clearField(3);
+}
+
+class ClientConfig extends $pb.GeneratedMessage {
+  factory ClientConfig({
+    $core.String? userAgent,
+    $fixnum.Int64? timeoutMs,
+    $fixnum.Int64? connectTimeoutMs,
+    $fixnum.Int64? tlsHandshakeTimeoutMs,
+    $fixnum.Int64? responseHeaderTimeoutMs,
+    $fixnum.Int64? idleConnTimeoutMs,
+    $core.int? maxIdleConnsPerHost,
+    $core.int? maxRedirects,
+    $core.String? proxyUrl,
+    $core.bool? insecureSkipVerify,
+    TlsVersion? minTlsVersion,
+    $fixnum.Int64? cacheTtlMs,
+    $fixnum.Int64? cacheMaxBytes,
+    $core.String? httpCacheDir,
+    $fixnum.Int64? httpCacheMaxBytes,
+    NetworkPolicy? networkPolicy,
+  }) {
+    final $result = create();
+    if (userAgent != null) {
+      $result.userAgent = userAgent;
+    }
+    if (timeoutMs != null) {
+      $result.timeoutMs = timeoutMs;
+    }
+    if (connectTimeoutMs != null) {
+      $result.connectTimeoutMs = connectTimeoutMs;
+    }
+    if (tlsHandshakeTimeoutMs != null) {
+      $result.tlsHandshakeTimeoutMs = tlsHandshakeTimeoutMs;
+    }
+    if (responseHeaderTimeoutMs != null) {
+      $result.responseHeaderTimeoutMs = responseHeaderTimeoutMs;
+    }
+    if (idleConnTimeoutMs != null) {
+      $result.idleConnTimeoutMs = idleConnTimeoutMs;
+    }
+    if (maxIdleConnsPerHost != null) {
+      $result.maxIdleConnsPerHost = maxIdleConnsPerHost;
+    }
+    if (maxRedirects != null) {
+      $result.maxRedirects = maxRedirects;
+    }
+    if (proxyUrl != null) {
+      $result.proxyUrl = proxyUrl;
+    }
+    if (insecureSkipVerify != null) {
+      $result.insecureSkipVerify = insecureSkipVerify;
+    }
+    if (minTlsVersion != null) {
+      $result.minTlsVersion = minTlsVersion;
+    }
+    if (cacheTtlMs != null) {
+      $result.cacheTtlMs = cacheTtlMs;
+    }
+    if (cacheMaxBytes != null) {
+      $result.cacheMaxBytes = cacheMaxBytes;
+    }
+    if (httpCacheDir != null) {
+      $result.httpCacheDir = httpCacheDir;
+    }
+    if (httpCacheMaxBytes != null) {
+      $result.httpCacheMaxBytes = httpCacheMaxBytes;
+    }
+    if (networkPolicy != null) {
+      $result.networkPolicy = networkPolicy;
+    }
+    return $result;
+  }
+  ClientConfig._() : super();
+  factory ClientConfig.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory ClientConfig.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'ClientConfig',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'userAgent')
+    ..aInt64(2, 'timeoutMs')
+    ..aInt64(3, 'connectTimeoutMs')
+    ..aInt64(4, 'tlsHandshakeTimeoutMs')
+    ..aInt64(5, 'responseHeaderTimeoutMs')
+    ..aInt64(6, 'idleConnTimeoutMs')
+    ..a<$core.int>(7, 'maxIdleConnsPerHost', $pb.PbFieldType.O3)
+    ..a<$core.int>(8, 'maxRedirects', $pb.PbFieldType.O3)
+    ..aOS(9, 'proxyUrl')
+    ..aOB(10, 'insecureSkipVerify')
+    ..e<TlsVersion>(
+      11,
+      'minTlsVersion',
+      $pb.PbFieldType.OE,
+      defaultOrMaker: TlsVersion.TLS_VERSION_DEFAULT,
+      valueOf: TlsVersion.valueOf,
+      enumValues: TlsVersion.values,
+    )
+    ..aInt64(12, 'cacheTtlMs')
+    ..aInt64(13, 'cacheMaxBytes')
+    ..aOS(14, 'httpCacheDir')
+    ..aInt64(15, 'httpCacheMaxBytes')
+    ..aOM<NetworkPolicy>(16, 'networkPolicy', subBuilder: NetworkPolicy.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  ClientConfig clone() => ClientConfig()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  ClientConfig copyWith(void Function(ClientConfig) updates) =>
+      super.copyWith((message) => updates(message as ClientConfig)) as ClientConfig;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ClientConfig create() => ClientConfig._();
+  ClientConfig createEmptyInstance() => create();
+  static $pb.PbList<ClientConfig> createRepeated() => $pb.PbList<ClientConfig>();
+  @$core.pragma('dart2js:noInline')
+  static ClientConfig getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ClientConfig>(create);
+  static ClientConfig? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get userAgent => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set userAgent($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasUserAgent() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUserAgent() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get timeoutMs => $_getI64(1);
+  @$pb.TagNumber(2)
+  set timeoutMs($fixnum.Int64 v) {
+    $_setInt64(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasTimeoutMs() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearTimeoutMs() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get connectTimeoutMs => $_getI64(2);
+  @$pb.TagNumber(3)
+  set connectTimeoutMs($fixnum.Int64 v) {
+    $_setInt64(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasConnectTimeoutMs() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearConnectTimeoutMs() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get tlsHandshakeTimeoutMs => $_getI64(3);
+  @$pb.TagNumber(4)
+  set tlsHandshakeTimeoutMs($fixnum.Int64 v) {
+    $_setInt64(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasTlsHandshakeTimeoutMs() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearTlsHandshakeTimeoutMs() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get responseHeaderTimeoutMs => $_getI64(4);
+  @$pb.TagNumber(5)
+  set responseHeaderTimeoutMs($fixnum.Int64 v) {
+    $_setInt64(4, v);
+  }
+
+  @$pb.TagNumber(5)
+  $core.bool hasResponseHeaderTimeoutMs() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearResponseHeaderTimeoutMs() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $fixnum.Int64 get idleConnTimeoutMs => $_getI64(5);
+  @$pb.TagNumber(6)
+  set idleConnTimeoutMs($fixnum.Int64 v) {
+    $_setInt64(5, v);
+  }
+
+  @$pb.TagNumber(6)
+  $core.bool hasIdleConnTimeoutMs() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearIdleConnTimeoutMs() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.int get maxIdleConnsPerHost => $_getIZ(6);
+  @$pb.TagNumber(7)
+  set maxIdleConnsPerHost($core.int v) {
+    $_setSignedInt32(6, v);
+  }
+
+  @$pb.TagNumber(7)
+  $core.bool hasMaxIdleConnsPerHost() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearMaxIdleConnsPerHost() => clearField(7);
+
+  @$pb.TagNumber(8)
+  $core.int get maxRedirects => $_getIZ(7);
+  @$pb.TagNumber(8)
+  set maxRedirects($core.int v) {
+    $_setSignedInt32(7, v);
+  }
+
+  @$pb.TagNumber(8)
+  $core.bool hasMaxRedirects() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearMaxRedirects() => clearField(8);
+
+  @$pb.TagNumber(9)
+  $core.String get proxyUrl => $_getSZ(8);
+  @$pb.TagNumber(9)
+  set proxyUrl($core.String v) {
+    $_setString(8, v);
+  }
+
+  @$pb.TagNumber(9)
+  $core.bool hasProxyUrl() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearProxyUrl() => clearField(9);
+
+  @$pb.TagNumber(10)
+  $core.bool get insecureSkipVerify => $_getBF(9);
+  @$pb.TagNumber(10)
+  set insecureSkipVerify($core.bool v) {
+    $_setBool(9, v);
+  }
+
+  @$pb.TagNumber(10)
+  $core.bool hasInsecureSkipVerify() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearInsecureSkipVerify() => clearField(10);
+
+  @$pb.TagNumber(11)
+  TlsVersion get minTlsVersion => $_getN(10);
+  @$pb.TagNumber(11)
+  set minTlsVersion(TlsVersion v) {
+    setField(11, v);
+  }
+
+  @$pb.TagNumber(11)
+  $core.bool hasMinTlsVersion() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearMinTlsVersion() => clearField(11);
+
+  @$pb.TagNumber(12)
+  $fixnum.Int64 get cacheTtlMs => $_getI64(11);
+  @$pb.TagNumber(12)
+  set cacheTtlMs($fixnum.Int64 v) {
+    $_setInt64(11, v);
+  }
+
+  @$pb.TagNumber(12)
+  $core.bool hasCacheTtlMs() => $_has(11);
+  @$pb.TagNumber(12)
+  void clearCacheTtlMs() => clearField(12);
+
+  @$pb.TagNumber(13)
+  $fixnum.Int64 get cacheMaxBytes => $_getI64(12);
+  @$pb.TagNumber(13)
+  set cacheMaxBytes($fixnum.Int64 v) {
+    $_setInt64(12, v);
+  }
+
+  @$pb.TagNumber(13)
+  $core.bool hasCacheMaxBytes() => $_has(12);
+  @$pb.TagNumber(13)
+  void clearCacheMaxBytes() => clearField(13);
+
+  @$pb.TagNumber(14)
+  $core.String get httpCacheDir => $_getSZ(13);
+  @$pb.TagNumber(14)
+  set httpCacheDir($core.String v) {
+    $_setString(13, v);
+  }
+
+  @$pb.TagNumber(14)
+  $core.bool hasHttpCacheDir() => $_has(13);
+  @$pb.TagNumber(14)
+  void clearHttpCacheDir() => clearField(14);
+
+  @$pb.TagNumber(15)
+  $fixnum.Int64 get httpCacheMaxBytes => $_getI64(14);
+  @$pb.TagNumber(15)
+  set httpCacheMaxBytes($fixnum.Int64 v) {
+    $_setInt64(14, v);
+  }
+
+  @$pb.TagNumber(15)
+  $core.bool hasHttpCacheMaxBytes() => $_has(14);
+  @$pb.TagNumber(15)
+  void clearHttpCacheMaxBytes() => clearField(15);
+
+  @$pb.TagNumber(16)
+  NetworkPolicy get networkPolicy => $_getN(15);
+  @$pb.TagNumber(16)
+  set networkPolicy(NetworkPolicy v) {
+    setField(16, v);
+  }
+
+  @$pb.TagNumber(16)
+  $core.bool hasNetworkPolicy() => $_has(15);
+  @$pb.TagNumber(16)
+  void clearNetworkPolicy() => clearField(16);
+  @$pb.TagNumber(16)
+  NetworkPolicy ensureNetworkPolicy() => $_ensure(15);
+}
+
+class ConfigureResponse extends $pb.GeneratedMessage {
+  factory ConfigureResponse({
+    $core.bool? success,
+    ErrorDetail? error,
+  }) {
+    final $result = create();
+    if (success != null) {
+      $result.success = success;
+    }
+    if (error != null) {
+      $result.error = error;
+    }
+    return $result;
+  }
+  ConfigureResponse._() : super();
+  factory ConfigureResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory ConfigureResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'ConfigureResponse',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOB(1, 'success')
+    ..aOM<ErrorDetail>(2, 'error', subBuilder: ErrorDetail.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  ConfigureResponse clone() => ConfigureResponse()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  ConfigureResponse copyWith(void Function(ConfigureResponse) updates) =>
+      super.copyWith((message) => updates(message as ConfigureResponse)) as ConfigureResponse;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ConfigureResponse create() => ConfigureResponse._();
+  ConfigureResponse createEmptyInstance() => create();
+  static $pb.PbList<ConfigureResponse> createRepeated() => $pb.PbList<ConfigureResponse>();
+  @$core.pragma('dart2js:noInline')
+  static ConfigureResponse getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ConfigureResponse>(create);
+  static ConfigureResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.bool get success => $_getBF(0);
+  @$pb.TagNumber(1)
+  set success($core.bool v) {
+    $_setBool(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasSuccess() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSuccess() => clearField(1);
+
+  @$pb.TagNumber(2)
+  ErrorDetail get error => $_getN(1);
+  @$pb.TagNumber(2)
+  set error(ErrorDetail v) {
+    setField(2, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasError() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearError() => clearField(2);
+  @$pb.TagNumber(2)
+  ErrorDetail ensureError() => $_ensure(1);
+}
+
+class DiscoverFeedsRequest extends $pb.GeneratedMessage {
+  factory DiscoverFeedsRequest({
+    $core.String? url,
+    $core.String? requestId,
+  }) {
+    final $result = create();
+    if (url != null) {
+      $result.url = url;
+    }
+    if (requestId != null) {
+      $result.requestId = requestId;
+    }
+    return $result;
+  }
+  DiscoverFeedsRequest._() : super();
+  factory DiscoverFeedsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory DiscoverFeedsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'DiscoverFeedsRequest',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'url')
+    ..aOS(2, 'requestId')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  DiscoverFeedsRequest clone() => DiscoverFeedsRequest()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  DiscoverFeedsRequest copyWith(void Function(DiscoverFeedsRequest) updates) =>
+      super.copyWith((message) => updates(message as DiscoverFeedsRequest)) as DiscoverFeedsRequest;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static DiscoverFeedsRequest create() => DiscoverFeedsRequest._();
+  DiscoverFeedsRequest createEmptyInstance() => create();
+  static $pb.PbList<DiscoverFeedsRequest> createRepeated() => $pb.PbList<DiscoverFeedsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static DiscoverFeedsRequest getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<DiscoverFeedsRequest>(create);
+  static DiscoverFeedsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get url => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set url($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasUrl() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUrl() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get requestId => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set requestId($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasRequestId() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearRequestId() => clearField(2);
+}
+
+class DiscoverFeedsResponse extends $pb.GeneratedMessage {
+  factory DiscoverFeedsResponse({
+    $core.Iterable<DiscoveredFeed>? feeds,
+    ErrorDetail? error,
+  }) {
+    final $result = create();
+    if (feeds != null) {
+      $result.feeds.addAll(feeds);
+    }
+    if (error != null) {
+      $result.error = error;
+    }
+    return $result;
+  }
+  DiscoverFeedsResponse._() : super();
+  factory DiscoverFeedsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory DiscoverFeedsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'DiscoverFeedsResponse',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..pc<DiscoveredFeed>(1, 'feeds', $pb.PbFieldType.PM, subBuilder: DiscoveredFeed.create)
+    ..aOM<ErrorDetail>(2, 'error', subBuilder: ErrorDetail.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  DiscoverFeedsResponse clone() => DiscoverFeedsResponse()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  DiscoverFeedsResponse copyWith(void Function(DiscoverFeedsResponse) updates) =>
+      super.copyWith((message) => updates(message as DiscoverFeedsResponse)) as DiscoverFeedsResponse;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static DiscoverFeedsResponse create() => DiscoverFeedsResponse._();
+  DiscoverFeedsResponse createEmptyInstance() => create();
+  static $pb.PbList<DiscoverFeedsResponse> createRepeated() => $pb.PbList<DiscoverFeedsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static DiscoverFeedsResponse getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<DiscoverFeedsResponse>(create);
+  static DiscoverFeedsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<DiscoveredFeed> get feeds => $_getList(0);
+
+  @$pb.TagNumber(2)
+  ErrorDetail get error => $_getN(1);
+  @$pb.TagNumber(2)
+  set error(ErrorDetail v) {
+    setField(2, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasError() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearError() => clearField(2);
+  @$pb.TagNumber(2)
+  ErrorDetail ensureError() => $_ensure(1);
+}
+
+class DiscoveredFeed extends $pb.GeneratedMessage {
+  factory DiscoveredFeed({
+    $core.String? url,
+    $core.String? title,
+    $core.String? mimeType,
+    DiscoverySource? source,
+  }) {
+    final $result = create();
+    if (url != null) {
+      $result.url = url;
+    }
+    if (title != null) {
+      $result.title = title;
+    }
+    if (mimeType != null) {
+      $result.mimeType = mimeType;
+    }
+    if (source != null) {
+      $result.source = source;
+    }
+    return $result;
+  }
+  DiscoveredFeed._() : super();
+  factory DiscoveredFeed.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory DiscoveredFeed.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'DiscoveredFeed',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'url')
+    ..aOS(2, 'title')
+    ..aOS(3, 'mimeType')
+    ..e<DiscoverySource>(
+      4,
+      'source',
+      $pb.PbFieldType.OE,
+      defaultOrMaker: DiscoverySource.DISCOVERY_SOURCE_UNKNOWN,
+      valueOf: DiscoverySource.valueOf,
+      enumValues: DiscoverySource.values,
+    )
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  DiscoveredFeed clone() => DiscoveredFeed()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  DiscoveredFeed copyWith(void Function(DiscoveredFeed) updates) =>
+      super.copyWith((message) => updates(message as DiscoveredFeed)) as DiscoveredFeed;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static DiscoveredFeed create() => DiscoveredFeed._();
+  DiscoveredFeed createEmptyInstance() => create();
+  static $pb.PbList<DiscoveredFeed> createRepeated() => $pb.PbList<DiscoveredFeed>();
+  @$core.pragma('dart2js:noInline')
+  static DiscoveredFeed getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<DiscoveredFeed>(create);
+  static DiscoveredFeed? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get url => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set url($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasUrl() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUrl() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get title => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set title($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasTitle() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearTitle() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get mimeType => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set mimeType($core.String v) {
+    $_setString(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasMimeType() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearMimeType() => clearField(3);
+
+  @$pb.TagNumber(4)
+  DiscoverySource get source => $_getN(3);
+  @$pb.TagNumber(4)
+  set source(DiscoverySource v) {
+    setField(4, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasSource() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearSource() => clearField(4);
+}
+
+class ImportOpmlRequest extends $pb.GeneratedMessage {
+  factory ImportOpmlRequest({
+    $core.List<$core.int>? opml,
+    $core.bool? validate,
+    $core.String? requestId,
+  }) {
+    final $result = create();
+    if (opml != null) {
+      $result.opml = opml;
+    }
+    if (validate != null) {
+      $result.validate = validate;
+    }
+    if (requestId != null) {
+      $result.requestId = requestId;
+    }
+    return $result;
+  }
+  ImportOpmlRequest._() : super();
+  factory ImportOpmlRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory ImportOpmlRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'ImportOpmlRequest',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..a<$core.List<$core.int>>(1, 'opml', $pb.PbFieldType.OY)
+    ..aOB(2, 'validate')
+    ..aOS(3, 'requestId')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  ImportOpmlRequest clone() => ImportOpmlRequest()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  ImportOpmlRequest copyWith(void Function(ImportOpmlRequest) updates) =>
+      super.copyWith((message) => updates(message as ImportOpmlRequest)) as ImportOpmlRequest;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ImportOpmlRequest create() => ImportOpmlRequest._();
+  ImportOpmlRequest createEmptyInstance() => create();
+  static $pb.PbList<ImportOpmlRequest> createRepeated() => $pb.PbList<ImportOpmlRequest>();
+  @$core.pragma('dart2js:noInline')
+  static ImportOpmlRequest getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ImportOpmlRequest>(create);
+  static ImportOpmlRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<$core.int> get opml => $_getN(0);
+  @$pb.TagNumber(1)
+  set opml($core.List<$core.int> v) {
+    $_setBytes(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasOpml() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearOpml() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.bool get validate => $_getBF(1);
+  @$pb.TagNumber(2)
+  set validate($core.bool v) {
+    $_setBool(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasValidate() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearValidate() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get requestId => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set requestId($core.String v) {
+    $_setString(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasRequestId() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearRequestId() => clearField(3);
+}
+
+class ImportOpmlResponse extends $pb.GeneratedMessage {
+  factory ImportOpmlResponse({
+    $core.String? title,
+    $core.Iterable<OpmlSubscription>? subscriptions,
+    ErrorDetail? error,
+  }) {
+    final $result = create();
+    if (title != null) {
+      $result.title = title;
+    }
+    if (subscriptions != null) {
+      $result.subscriptions.addAll(subscriptions);
+    }
+    if (error != null) {
+      $result.error = error;
+    }
+    return $result;
+  }
+  ImportOpmlResponse._() : super();
+  factory ImportOpmlResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory ImportOpmlResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'ImportOpmlResponse',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'title')
+    ..pc<OpmlSubscription>(2, 'subscriptions', $pb.PbFieldType.PM, subBuilder: OpmlSubscription.create)
+    ..aOM<ErrorDetail>(3, 'error', subBuilder: ErrorDetail.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  ImportOpmlResponse clone() => ImportOpmlResponse()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  ImportOpmlResponse copyWith(void Function(ImportOpmlResponse) updates) =>
+      super.copyWith((message) => updates(message as ImportOpmlResponse)) as ImportOpmlResponse;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ImportOpmlResponse create() => ImportOpmlResponse._();
+  ImportOpmlResponse createEmptyInstance() => create();
+  static $pb.PbList<ImportOpmlResponse> createRepeated() => $pb.PbList<ImportOpmlResponse>();
+  @$core.pragma('dart2js:noInline')
+  static ImportOpmlResponse getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ImportOpmlResponse>(create);
+  static ImportOpmlResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get title => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set title($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasTitle() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearTitle() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<OpmlSubscription> get subscriptions => $_getList(1);
+
+  @$pb.TagNumber(3)
+  ErrorDetail get error => $_getN(2);
+  @$pb.TagNumber(3)
+  set error(ErrorDetail v) {
+    setField(3, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasError() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearError() => clearField(3);
+  @$pb.TagNumber(3)
+  ErrorDetail ensureError() => $_ensure(2);
+}
+
+class OpmlSubscription extends $pb.GeneratedMessage {
+  factory OpmlSubscription({
+    $core.String? title,
+    $core.String? xmlUrl,
+    $core.String? htmlUrl,
+    $core.Iterable<$core.String>? categories,
+    $core.bool? valid,
+    ErrorDetail? validationError,
+  }) {
+    final $result = create();
+    if (title != null) {
+      $result.title = title;
+    }
+    if (xmlUrl != null) {
+      $result.xmlUrl = xmlUrl;
+    }
+    if (htmlUrl != null) {
+      $result.htmlUrl = htmlUrl;
+    }
+    if (categories != null) {
+      $result.categories.addAll(categories);
+    }
+    if (valid != null) {
+      $result.valid = valid;
+    }
+    if (validationError != null) {
+      $result.validationError = validationError;
+    }
+    return $result;
+  }
+  OpmlSubscription._() : super();
+  factory OpmlSubscription.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory OpmlSubscription.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'OpmlSubscription',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'title')
+    ..aOS(2, 'xmlUrl')
+    ..aOS(3, 'htmlUrl')
+    ..pPS(4, 'categories')
+    ..aOB(5, 'valid')
+    ..aOM<ErrorDetail>(6, 'validationError', subBuilder: ErrorDetail.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  OpmlSubscription clone() => OpmlSubscription()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  OpmlSubscription copyWith(void Function(OpmlSubscription) updates) =>
+      super.copyWith((message) => updates(message as OpmlSubscription)) as OpmlSubscription;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static OpmlSubscription create() => OpmlSubscription._();
+  OpmlSubscription createEmptyInstance() => create();
+  static $pb.PbList<OpmlSubscription> createRepeated() => $pb.PbList<OpmlSubscription>();
+  @$core.pragma('dart2js:noInline')
+  static OpmlSubscription getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<OpmlSubscription>(create);
+  static OpmlSubscription? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get title => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set title($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasTitle() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearTitle() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get xmlUrl => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set xmlUrl($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasXmlUrl() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearXmlUrl() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get htmlUrl => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set htmlUrl($core.String v) {
+    $_setString(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasHtmlUrl() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearHtmlUrl() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.List<$core.String> get categories => $_getList(3);
+
+  @$pb.TagNumber(5)
+  $core.bool get valid => $_getBF(4);
+  @$pb.TagNumber(5)
+  set valid($core.bool v) {
+    $_setBool(4, v);
+  }
+
+  @$pb.TagNumber(5)
+  $core.bool hasValid() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearValid() => clearField(5);
+
+  @$pb.TagNumber(6)
+  ErrorDetail get validationError => $_getN(5);
+  @$pb.TagNumber(6)
+  set validationError(ErrorDetail v) {
+    setField(6, v);
+  }
+
+  @$pb.TagNumber(6)
+  $core.bool hasValidationError() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearValidationError() => clearField(6);
+  @$pb.TagNumber(6)
+  ErrorDetail ensureValidationError() => $_ensure(5);
+}
+
+class ExportOpmlRequest extends $pb.GeneratedMessage {
+  factory ExportOpmlRequest({
+    $core.String? title,
+    $core.Iterable<Feed>? feeds,
+  }) {
+    final $result = create();
+    if (title != null) {
+      $result.title = title;
+    }
+    if (feeds != null) {
+      $result.feeds.addAll(feeds);
+    }
+    return $result;
+  }
+  ExportOpmlRequest._() : super();
+  factory ExportOpmlRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory ExportOpmlRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'ExportOpmlRequest',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'title')
+    ..pc<Feed>(2, 'feeds', $pb.PbFieldType.PM, subBuilder: Feed.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  ExportOpmlRequest clone() => ExportOpmlRequest()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  ExportOpmlRequest copyWith(void Function(ExportOpmlRequest) updates) =>
+      super.copyWith((message) => updates(message as ExportOpmlRequest)) as ExportOpmlRequest;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ExportOpmlRequest create() => ExportOpmlRequest._();
+  ExportOpmlRequest createEmptyInstance() => create();
+  static $pb.PbList<ExportOpmlRequest> createRepeated() => $pb.PbList<ExportOpmlRequest>();
+  @$core.pragma('dart2js:noInline')
+  static ExportOpmlRequest getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ExportOpmlRequest>(create);
+  static ExportOpmlRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get title => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set title($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasTitle() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearTitle() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<Feed> get feeds => $_getList(1);
+}
+
+class ExportOpmlResponse extends $pb.GeneratedMessage {
+  factory ExportOpmlResponse({
+    $core.List<$core.int>? opml,
+    ErrorDetail? error,
+  }) {
+    final $result = create();
+    if (opml != null) {
+      $result.opml = opml;
+    }
+    if (error != null) {
+      $result.error = error;
+    }
+    return $result;
+  }
+  ExportOpmlResponse._() : super();
+  factory ExportOpmlResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory ExportOpmlResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'ExportOpmlResponse',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..a<$core.List<$core.int>>(1, 'opml', $pb.PbFieldType.OY)
+    ..aOM<ErrorDetail>(2, 'error', subBuilder: ErrorDetail.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  ExportOpmlResponse clone() => ExportOpmlResponse()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  ExportOpmlResponse copyWith(void Function(ExportOpmlResponse) updates) =>
+      super.copyWith((message) => updates(message as ExportOpmlResponse)) as ExportOpmlResponse;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ExportOpmlResponse create() => ExportOpmlResponse._();
+  ExportOpmlResponse createEmptyInstance() => create();
+  static $pb.PbList<ExportOpmlResponse> createRepeated() => $pb.PbList<ExportOpmlResponse>();
+  @$core.pragma('dart2js:noInline')
+  static ExportOpmlResponse getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ExportOpmlResponse>(create);
+  static ExportOpmlResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<$core.int> get opml => $_getN(0);
+  @$pb.TagNumber(1)
+  set opml($core.List<$core.int> v) {
+    $_setBytes(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasOpml() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearOpml() => clearField(1);
+
+  @$pb.TagNumber(2)
+  ErrorDetail get error => $_getN(1);
+  @$pb.TagNumber(2)
+  set error(ErrorDetail v) {
+    setField(2, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasError() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearError() => clearField(2);
+  @$pb.TagNumber(2)
+  ErrorDetail ensureError() => $_ensure(1);
+}
+
+class PodcastChannel extends $pb.GeneratedMessage {
+  factory PodcastChannel({
+    $core.String? author,
+    $core.String? summary,
+    $core.String? image,
+    $core.bool? explicit,
+    $core.Iterable<$core.String>? categories,
+    $core.String? type,
+    $core.String? ownerName,
+    $core.String? ownerEmail,
+    $core.bool? complete,
+    $core.String? newFeedUrl,
+  }) {
+    final $result = create();
+    if (author != null) {
+      $result.author = author;
+    }
+    if (summary != null) {
+      $result.summary = summary;
+    }
+    if (image != null) {
+      $result.image = image;
+    }
+    if (explicit != null) {
+      $result.explicit = explicit;
+    }
+    if (categories != null) {
+      $result.categories.addAll(categories);
+    }
+    if (type != null) {
+      $result.type = type;
+    }
+    if (ownerName != null) {
+      $result.ownerName = ownerName;
+    }
+    if (ownerEmail != null) {
+      $result.ownerEmail = ownerEmail;
+    }
+    if (complete != null) {
+      $result.complete = complete;
+    }
+    if (newFeedUrl != null) {
+      $result.newFeedUrl = newFeedUrl;
+    }
+    return $result;
+  }
+  PodcastChannel._() : super();
+  factory PodcastChannel.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory PodcastChannel.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'PodcastChannel',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'author')
+    ..aOS(2, 'summary')
+    ..aOS(3, 'image')
+    ..aOB(4, 'explicit')
+    ..pPS(5, 'categories')
+    ..aOS(6, 'type')
+    ..aOS(7, 'ownerName')
+    ..aOS(8, 'ownerEmail')
+    ..aOB(9, 'complete')
+    ..aOS(10, 'newFeedUrl')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  PodcastChannel clone() => PodcastChannel()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  PodcastChannel copyWith(void Function(PodcastChannel) updates) =>
+      super.copyWith((message) => updates(message as PodcastChannel)) as PodcastChannel;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static PodcastChannel create() => PodcastChannel._();
+  PodcastChannel createEmptyInstance() => create();
+  static $pb.PbList<PodcastChannel> createRepeated() => $pb.PbList<PodcastChannel>();
+  @$core.pragma('dart2js:noInline')
+  static PodcastChannel getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<PodcastChannel>(create);
+  static PodcastChannel? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get author => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set author($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasAuthor() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearAuthor() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get summary => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set summary($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasSummary() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearSummary() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get image => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set image($core.String v) {
+    $_setString(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasImage() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearImage() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.bool get explicit => $_getBF(3);
+  @$pb.TagNumber(4)
+  set explicit($core.bool v) {
+    $_setBool(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasExplicit() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearExplicit() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.List<$core.String> get categories => $_getList(4);
+
+  @$pb.TagNumber(6)
+  $core.String get type => $_getSZ(5);
+  @$pb.TagNumber(6)
+  set type($core.String v) {
+    $_setString(5, v);
+  }
+
+  @$pb.TagNumber(6)
+  $core.bool hasType() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearType() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.String get ownerName => $_getSZ(6);
+  @$pb.TagNumber(7)
+  set ownerName($core.String v) {
+    $_setString(6, v);
+  }
+
+  @$pb.TagNumber(7)
+  $core.bool hasOwnerName() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearOwnerName() => clearField(7);
+
+  @$pb.TagNumber(8)
+  $core.String get ownerEmail => $_getSZ(7);
+  @$pb.TagNumber(8)
+  set ownerEmail($core.String v) {
+    $_setString(7, v);
+  }
+
+  @$pb.TagNumber(8)
+  $core.bool hasOwnerEmail() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearOwnerEmail() => clearField(8);
+
+  @$pb.TagNumber(9)
+  $core.bool get complete => $_getBF(8);
+  @$pb.TagNumber(9)
+  set complete($core.bool v) {
+    $_setBool(8, v);
+  }
+
+  @$pb.TagNumber(9)
+  $core.bool hasComplete() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearComplete() => clearField(9);
+
+  @$pb.TagNumber(10)
+  $core.String get newFeedUrl => $_getSZ(9);
+  @$pb.TagNumber(10)
+  set newFeedUrl($core.String v) {
+    $_setString(9, v);
+  }
+
+  @$pb.TagNumber(10)
+  $core.bool hasNewFeedUrl() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearNewFeedUrl() => clearField(10);
+}
+
+class Enclosure extends $pb.GeneratedMessage {
+  factory Enclosure({
+    $core.String? url,
+    $core.String? mimeType,
+    $fixnum.Int64? length,
+  }) {
+    final $result = create();
+    if (url != null) {
+      $result.url = url;
+    }
+    if (mimeType != null) {
+      $result.mimeType = mimeType;
+    }
+    if (length != null) {
+      $result.length = length;
+    }
+    return $result;
+  }
+  Enclosure._() : super();
+  factory Enclosure.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory Enclosure.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'Enclosure',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'url')
+    ..aOS(2, 'mimeType')
+    ..aInt64(3, 'length')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  Enclosure clone() => Enclosure()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  Enclosure copyWith(void Function(Enclosure) updates) =>
+      super.copyWith((message) => updates(message as Enclosure)) as Enclosure;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static Enclosure create() => Enclosure._();
+  Enclosure createEmptyInstance() => create();
+  static $pb.PbList<Enclosure> createRepeated() => $pb.PbList<Enclosure>();
+  @$core.pragma('dart2js:noInline')
+  static Enclosure getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<Enclosure>(create);
+  static Enclosure? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get url => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set url($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasUrl() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUrl() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get mimeType => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set mimeType($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasMimeType() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearMimeType() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get length => $_getI64(2);
+  @$pb.TagNumber(3)
+  set length($fixnum.Int64 v) {
+    $_setInt64(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasLength() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearLength() => clearField(3);
+}
+
+class PodcastEpisode extends $pb.GeneratedMessage {
+  factory PodcastEpisode({
+    $core.String? duration,
+    $fixnum.Int64? durationSeconds,
+    $core.int? episode,
+    $core.int? season,
+    $core.bool? explicit,
+    $core.String? image,
+    $core.String? episodeType,
+  }) {
+    final $result = create();
+    if (duration != null) {
+      $result.duration = duration;
+    }
+    if (durationSeconds != null) {
+      $result.durationSeconds = durationSeconds;
+    }
+    if (episode != null) {
+      $result.episode = episode;
+    }
+    if (season != null) {
+      $result.season = season;
+    }
+    if (explicit != null) {
+      $result.explicit = explicit;
+    }
+    if (image != null) {
+      $result.image = image;
+    }
+    if (episodeType != null) {
+      $result.episodeType = episodeType;
+    }
+    return $result;
+  }
+  PodcastEpisode._() : super();
+  factory PodcastEpisode.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory PodcastEpisode.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'PodcastEpisode',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'duration')
+    ..aInt64(2, 'durationSeconds')
+    ..a<$core.int>(3, 'episode', $pb.PbFieldType.O3)
+    ..a<$core.int>(4, 'season', $pb.PbFieldType.O3)
+    ..aOB(5, 'explicit')
+    ..aOS(6, 'image')
+    ..aOS(7, 'episodeType')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  PodcastEpisode clone() => PodcastEpisode()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  PodcastEpisode copyWith(void Function(PodcastEpisode) updates) =>
+      super.copyWith((message) => updates(message as PodcastEpisode)) as PodcastEpisode;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static PodcastEpisode create() => PodcastEpisode._();
+  PodcastEpisode createEmptyInstance() => create();
+  static $pb.PbList<PodcastEpisode> createRepeated() => $pb.PbList<PodcastEpisode>();
+  @$core.pragma('dart2js:noInline')
+  static PodcastEpisode getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<PodcastEpisode>(create);
+  static PodcastEpisode? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get duration => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set duration($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasDuration() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearDuration() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get durationSeconds => $_getI64(1);
+  @$pb.TagNumber(2)
+  set durationSeconds($fixnum.Int64 v) {
+    $_setInt64(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasDurationSeconds() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearDurationSeconds() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get episode => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set episode($core.int v) {
+    $_setSignedInt32(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasEpisode() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearEpisode() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get season => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set season($core.int v) {
+    $_setSignedInt32(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasSeason() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearSeason() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.bool get explicit => $_getBF(4);
+  @$pb.TagNumber(5)
+  set explicit($core.bool v) {
+    $_setBool(4, v);
+  }
+
+  @$pb.TagNumber(5)
+  $core.bool hasExplicit() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearExplicit() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.String get image => $_getSZ(5);
+  @$pb.TagNumber(6)
+  set image($core.String v) {
+    $_setString(5, v);
+  }
+
+  @$pb.TagNumber(6)
+  $core.bool hasImage() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearImage() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.String get episodeType => $_getSZ(6);
+  @$pb.TagNumber(7)
+  set episodeType($core.String v) {
+    $_setString(6, v);
+  }
+
+  @$pb.TagNumber(7)
+  $core.bool hasEpisodeType() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearEpisodeType() => clearField(7);
+}
+
+enum ParseStreamEvent_Event {
+  feed,
+  error,
+  summary,
+  notSet
+}
+
+class ParseStreamEvent extends $pb.GeneratedMessage {
+  factory ParseStreamEvent({
+    Feed? feed,
+    ErrorDetail? error,
+    ParseStreamSummary? summary,
+  }) {
+    final $result = create();
+    if (feed != null) {
+      $result.feed = feed;
+    }
+    if (error != null) {
+      $result.error = error;
+    }
+    if (summary != null) {
+      $result.summary = summary;
+    }
+    return $result;
+  }
+  ParseStreamEvent._() : super();
+  factory ParseStreamEvent.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory ParseStreamEvent.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static const $core.Map<$core.int, ParseStreamEvent_Event> _ParseStreamEvent_EventByTag = {
+    1: ParseStreamEvent_Event.feed,
+    2: ParseStreamEvent_Event.error,
+    3: ParseStreamEvent_Event.summary,
+    0: ParseStreamEvent_Event.notSet,
+  };
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'ParseStreamEvent',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..oo(0, [1, 2, 3])
+    ..aOM<Feed>(1, 'feed', subBuilder: Feed.create)
+    ..aOM<ErrorDetail>(2, 'error', subBuilder: ErrorDetail.create)
+    ..aOM<ParseStreamSummary>(3, 'summary', subBuilder: ParseStreamSummary.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  ParseStreamEvent clone() => ParseStreamEvent()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  ParseStreamEvent copyWith(void Function(ParseStreamEvent) updates) =>
+      super.copyWith((message) => updates(message as ParseStreamEvent)) as ParseStreamEvent;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ParseStreamEvent create() => ParseStreamEvent._();
+  ParseStreamEvent createEmptyInstance() => create();
+  static $pb.PbList<ParseStreamEvent> createRepeated() => $pb.PbList<ParseStreamEvent>();
+  @$core.pragma('dart2js:noInline')
+  static ParseStreamEvent getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ParseStreamEvent>(create);
+  static ParseStreamEvent? _defaultInstance;
+
+  ParseStreamEvent_Event whichEvent() => _ParseStreamEvent_EventByTag[$_whichOneof(0)]!;
+  void clearEvent() => clearField($_whichOneof(0));
+
+  @$pb.TagNumber(1)
+  Feed get feed => $_getN(0);
+  @$pb.TagNumber(1)
+  set feed(Feed v) {
+    setField(1, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasFeed() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearFeed() => clearField(1);
+  @$pb.TagNumber(1)
+  Feed ensureFeed() => $_ensure(0);
+
+  @$pb.TagNumber(2)
+  ErrorDetail get error => $_getN(1);
+  @$pb.TagNumber(2)
+  set error(ErrorDetail v) {
+    setField(2, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasError() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearError() => clearField(2);
+  @$pb.TagNumber(2)
+  ErrorDetail ensureError() => $_ensure(1);
+
+  @$pb.TagNumber(3)
+  ParseStreamSummary get summary => $_getN(2);
+  @$pb.TagNumber(3)
+  set summary(ParseStreamSummary v) {
+    setField(3, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasSummary() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearSummary() => clearField(3);
+  @$pb.TagNumber(3)
+  ParseStreamSummary ensureSummary() => $_ensure(2);
+}
+
+class ParseStreamSummary extends $pb.GeneratedMessage {
+  factory ParseStreamSummary({
+    ParseFeedsStatus? status,
+    $core.int? feedCount,
+    $core.int? errorCount,
+    ErrorDetail? fatalError,
+  }) {
+    final $result = create();
+    if (status != null) {
+      $result.status = status;
+    }
+    if (feedCount != null) {
+      $result.feedCount = feedCount;
+    }
+    if (errorCount != null) {
+      $result.errorCount = errorCount;
+    }
+    if (fatalError != null) {
+      $result.fatalError = fatalError;
+    }
+    return $result;
+  }
+  ParseStreamSummary._() : super();
+  factory ParseStreamSummary.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory ParseStreamSummary.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'ParseStreamSummary',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..e<ParseFeedsStatus>(
+      1,
+      'status',
+      $pb.PbFieldType.OE,
+      defaultOrMaker: ParseFeedsStatus.SUCCESS,
+      valueOf: ParseFeedsStatus.valueOf,
+      enumValues: ParseFeedsStatus.values,
+    )
+    ..a<$core.int>(2, 'feedCount', $pb.PbFieldType.O3)
+    ..a<$core.int>(3, 'errorCount', $pb.PbFieldType.O3)
+    ..aOM<ErrorDetail>(4, 'fatalError', subBuilder: ErrorDetail.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  ParseStreamSummary clone() => ParseStreamSummary()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  ParseStreamSummary copyWith(void Function(ParseStreamSummary) updates) =>
+      super.copyWith((message) => updates(message as ParseStreamSummary)) as ParseStreamSummary;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ParseStreamSummary create() => ParseStreamSummary._();
+  ParseStreamSummary createEmptyInstance() => create();
+  static $pb.PbList<ParseStreamSummary> createRepeated() => $pb.PbList<ParseStreamSummary>();
+  @$core.pragma('dart2js:noInline')
+  static ParseStreamSummary getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ParseStreamSummary>(create);
+  static ParseStreamSummary? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  ParseFeedsStatus get status => $_getN(0);
+  @$pb.TagNumber(1)
+  set status(ParseFeedsStatus v) {
+    setField(1, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasStatus() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearStatus() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.int get feedCount => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set feedCount($core.int v) {
+    $_setSignedInt32(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasFeedCount() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearFeedCount() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get errorCount => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set errorCount($core.int v) {
+    $_setSignedInt32(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasErrorCount() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearErrorCount() => clearField(3);
+
+  @$pb.TagNumber(4)
+  ErrorDetail get fatalError => $_getN(3);
+  @$pb.TagNumber(4)
+  set fatalError(ErrorDetail v) {
+    setField(4, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasFatalError() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearFatalError() => clearField(4);
+  @$pb.TagNumber(4)
+  ErrorDetail ensureFatalError() => $_ensure(3);
+}
+
+class CancelRequest extends $pb.GeneratedMessage {
+  factory CancelRequest({
+    $core.String? requestId,
+  }) {
+    final $result = create();
+    if (requestId != null) {
+      $result.requestId = requestId;
+    }
+    return $result;
+  }
+  CancelRequest._() : super();
+  factory CancelRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory CancelRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'CancelRequest',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'requestId')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  CancelRequest clone() => CancelRequest()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  CancelRequest copyWith(void Function(CancelRequest) updates) =>
+      super.copyWith((message) => updates(message as CancelRequest)) as CancelRequest;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static CancelRequest create() => CancelRequest._();
+  CancelRequest createEmptyInstance() => create();
+  static $pb.PbList<CancelRequest> createRepeated() => $pb.PbList<CancelRequest>();
+  @$core.pragma('dart2js:noInline')
+  static CancelRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CancelRequest>(create);
+  static CancelRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get requestId => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set requestId($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasRequestId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearRequestId() => clearField(1);
+}
+
+class CancelResponse extends $pb.GeneratedMessage {
+  factory CancelResponse({
+    $core.bool? cancelled,
+  }) {
+    final $result = create();
+    if (cancelled != null) {
+      $result.cancelled = cancelled;
+    }
+    return $result;
+  }
+  CancelResponse._() : super();
+  factory CancelResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory CancelResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'CancelResponse',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOB(1, 'cancelled')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  CancelResponse clone() => CancelResponse()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  CancelResponse copyWith(void Function(CancelResponse) updates) =>
+      super.copyWith((message) => updates(message as CancelResponse)) as CancelResponse;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static CancelResponse create() => CancelResponse._();
+  CancelResponse createEmptyInstance() => create();
+  static $pb.PbList<CancelResponse> createRepeated() => $pb.PbList<CancelResponse>();
+  @$core.pragma('dart2js:noInline')
+  static CancelResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CancelResponse>(create);
+  static CancelResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.bool get cancelled => $_getBF(0);
+  @$pb.TagNumber(1)
+  set cancelled($core.bool v) {
+    $_setBool(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasCancelled() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCancelled() => clearField(1);
+}
+
+class FetchDiagnostics extends $pb.GeneratedMessage {
+  factory FetchDiagnostics({
+    $core.int? statusCode,
+    $core.String? finalUrl,
+    $core.String? contentType,
+    $fixnum.Int64? bytesDownloaded,
+    $fixnum.Int64? dnsMs,
+    $fixnum.Int64? connectMs,
+    $fixnum.Int64? tlsMs,
+    $fixnum.Int64? ttfbMs,
+    $fixnum.Int64? parseMs,
+    $fixnum.Int64? totalMs,
+    $core.bool? connectionReused,
+    $core.int? retryCount,
+    HttpCacheStatus? httpCache,
+  }) {
+    final $result = create();
+    if (statusCode != null) {
+      $result.statusCode = statusCode;
+    }
+    if (finalUrl != null) {
+      $result.finalUrl = finalUrl;
+    }
+    if (contentType != null) {
+      $result.contentType = contentType;
+    }
+    if (bytesDownloaded != null) {
+      $result.bytesDownloaded = bytesDownloaded;
+    }
+    if (dnsMs != null) {
+      $result.dnsMs = dnsMs;
+    }
+    if (connectMs != null) {
+      $result.connectMs = connectMs;
+    }
+    if (tlsMs != null) {
+      $result.tlsMs = tlsMs;
+    }
+    if (ttfbMs != null) {
+      $result.ttfbMs = ttfbMs;
+    }
+    if (parseMs != null) {
+      $result.parseMs = parseMs;
+    }
+    if (totalMs != null) {
+      $result.totalMs = totalMs;
+    }
+    if (connectionReused != null) {
+      $result.connectionReused = connectionReused;
+    }
+    if (retryCount != null) {
+      $result.retryCount = retryCount;
+    }
+    if (httpCache != null) {
+      $result.httpCache = httpCache;
+    }
+    return $result;
+  }
+  FetchDiagnostics._() : super();
+  factory FetchDiagnostics.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory FetchDiagnostics.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'FetchDiagnostics',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..a<$core.int>(1, 'statusCode', $pb.PbFieldType.O3)
+    ..aOS(2, 'finalUrl')
+    ..aOS(3, 'contentType')
+    ..aInt64(4, 'bytesDownloaded')
+    ..aInt64(5, 'dnsMs')
+    ..aInt64(6, 'connectMs')
+    ..aInt64(7, 'tlsMs')
+    ..aInt64(8, 'ttfbMs')
+    ..aInt64(9, 'parseMs')
+    ..aInt64(10, 'totalMs')
+    ..aOB(11, 'connectionReused')
+    ..a<$core.int>(12, 'retryCount', $pb.PbFieldType.O3)
+    ..e<HttpCacheStatus>(
+      13,
+      'httpCache',
+      $pb.PbFieldType.OE,
+      defaultOrMaker: HttpCacheStatus.HTTP_CACHE_STATUS_NONE,
+      valueOf: HttpCacheStatus.valueOf,
+      enumValues: HttpCacheStatus.values,
+    )
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  FetchDiagnostics clone() => FetchDiagnostics()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  FetchDiagnostics copyWith(void Function(FetchDiagnostics) updates) =>
+      super.copyWith((message) => updates(message as FetchDiagnostics)) as FetchDiagnostics;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static FetchDiagnostics create() => FetchDiagnostics._();
+  FetchDiagnostics createEmptyInstance() => create();
+  static $pb.PbList<FetchDiagnostics> createRepeated() => $pb.PbList<FetchDiagnostics>();
+  @$core.pragma('dart2js:noInline')
+  static FetchDiagnostics getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<FetchDiagnostics>(create);
+  static FetchDiagnostics? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get statusCode => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set statusCode($core.int v) {
+    $_setSignedInt32(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasStatusCode() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearStatusCode() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get finalUrl => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set finalUrl($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasFinalUrl() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearFinalUrl() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get contentType => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set contentType($core.String v) {
+    $_setString(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasContentType() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearContentType() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get bytesDownloaded => $_getI64(3);
+  @$pb.TagNumber(4)
+  set bytesDownloaded($fixnum.Int64 v) {
+    $_setInt64(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasBytesDownloaded() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearBytesDownloaded() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get dnsMs => $_getI64(4);
+  @$pb.TagNumber(5)
+  set dnsMs($fixnum.Int64 v) {
+    $_setInt64(4, v);
+  }
+
+  @$pb.TagNumber(5)
+  $core.bool hasDnsMs() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearDnsMs() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $fixnum.Int64 get connectMs => $_getI64(5);
+  @$pb.TagNumber(6)
+  set connectMs($fixnum.Int64 v) {
+    $_setInt64(5, v);
+  }
+
+  @$pb.TagNumber(6)
+  $core.bool hasConnectMs() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearConnectMs() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $fixnum.Int64 get tlsMs => $_getI64(6);
+  @$pb.TagNumber(7)
+  set tlsMs($fixnum.Int64 v) {
+    $_setInt64(6, v);
+  }
+
+  @$pb.TagNumber(7)
+  $core.bool hasTlsMs() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearTlsMs() => clearField(7);
+
+  @$pb.TagNumber(8)
+  $fixnum.Int64 get ttfbMs => $_getI64(7);
+  @$pb.TagNumber(8)
+  set ttfbMs($fixnum.Int64 v) {
+    $_setInt64(7, v);
+  }
+
+  @$pb.TagNumber(8)
+  $core.bool hasTtfbMs() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearTtfbMs() => clearField(8);
+
+  @$pb.TagNumber(9)
+  $fixnum.Int64 get parseMs => $_getI64(8);
+  @$pb.TagNumber(9)
+  set parseMs($fixnum.Int64 v) {
+    $_setInt64(8, v);
+  }
+
+  @$pb.TagNumber(9)
+  $core.bool hasParseMs() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearParseMs() => clearField(9);
+
+  @$pb.TagNumber(10)
+  $fixnum.Int64 get totalMs => $_getI64(9);
+  @$pb.TagNumber(10)
+  set totalMs($fixnum.Int64 v) {
+    $_setInt64(9, v);
+  }
+
+  @$pb.TagNumber(10)
+  $core.bool hasTotalMs() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearTotalMs() => clearField(10);
+
+  @$pb.TagNumber(11)
+  $core.bool get connectionReused => $_getBF(10);
+  @$pb.TagNumber(11)
+  set connectionReused($core.bool v) {
+    $_setBool(10, v);
+  }
+
+  @$pb.TagNumber(11)
+  $core.bool hasConnectionReused() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearConnectionReused() => clearField(11);
+
+  @$pb.TagNumber(12)
+  $core.int get retryCount => $_getIZ(11);
+  @$pb.TagNumber(12)
+  set retryCount($core.int v) {
+    $_setSignedInt32(11, v);
+  }
+
+  @$pb.TagNumber(12)
+  $core.bool hasRetryCount() => $_has(11);
+  @$pb.TagNumber(12)
+  void clearRetryCount() => clearField(12);
+
+  @$pb.TagNumber(13)
+  HttpCacheStatus get httpCache => $_getN(12);
+  @$pb.TagNumber(13)
+  set httpCache(HttpCacheStatus v) {
+    setField(13, v);
+  }
+
+  @$pb.TagNumber(13)
+  $core.bool hasHttpCache() => $_has(12);
+  @$pb.TagNumber(13)
+  void clearHttpCache() => clearField(13);
+}
+
+class RetryPolicy extends $pb.GeneratedMessage {
+  factory RetryPolicy({
+    $core.int? maxAttempts,
+    $fixnum.Int64? baseDelayMs,
+    $fixnum.Int64? maxDelayMs,
+    $core.double? jitter,
+  }) {
+    final $result = create();
+    if (maxAttempts != null) {
+      $result.maxAttempts = maxAttempts;
+    }
+    if (baseDelayMs != null) {
+      $result.baseDelayMs = baseDelayMs;
+    }
+    if (maxDelayMs != null) {
+      $result.maxDelayMs = maxDelayMs;
+    }
+    if (jitter != null) {
+      $result.jitter = jitter;
+    }
+    return $result;
+  }
+  RetryPolicy._() : super();
+  factory RetryPolicy.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory RetryPolicy.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'RetryPolicy',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..a<$core.int>(1, 'maxAttempts', $pb.PbFieldType.O3)
+    ..aInt64(2, 'baseDelayMs')
+    ..aInt64(3, 'maxDelayMs')
+    ..a<$core.double>(4, 'jitter', $pb.PbFieldType.OD)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  RetryPolicy clone() => RetryPolicy()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  RetryPolicy copyWith(void Function(RetryPolicy) updates) =>
+      super.copyWith((message) => updates(message as RetryPolicy)) as RetryPolicy;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static RetryPolicy create() => RetryPolicy._();
+  RetryPolicy createEmptyInstance() => create();
+  static $pb.PbList<RetryPolicy> createRepeated() => $pb.PbList<RetryPolicy>();
+  @$core.pragma('dart2js:noInline')
+  static RetryPolicy getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<RetryPolicy>(create);
+  static RetryPolicy? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get maxAttempts => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set maxAttempts($core.int v) {
+    $_setSignedInt32(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasMaxAttempts() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearMaxAttempts() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get baseDelayMs => $_getI64(1);
+  @$pb.TagNumber(2)
+  set baseDelayMs($fixnum.Int64 v) {
+    $_setInt64(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasBaseDelayMs() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearBaseDelayMs() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get maxDelayMs => $_getI64(2);
+  @$pb.TagNumber(3)
+  set maxDelayMs($fixnum.Int64 v) {
+    $_setInt64(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasMaxDelayMs() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearMaxDelayMs() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.double get jitter => $_getN(3);
+  @$pb.TagNumber(4)
+  set jitter($core.double v) {
+    $_setDouble(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasJitter() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearJitter() => clearField(4);
+}
+
+class JsonFeedChannel extends $pb.GeneratedMessage {
+  factory JsonFeedChannel({
+    $core.String? homePageUrl,
+    $core.String? feedUrl,
+    $core.String? icon,
+    $core.String? favicon,
+    $core.String? nextUrl,
+    $core.String? language,
+    $core.bool? expired,
+    $core.Iterable<Author>? authors,
+  }) {
+    final $result = create();
+    if (homePageUrl != null) {
+      $result.homePageUrl = homePageUrl;
+    }
+    if (feedUrl != null) {
+      $result.feedUrl = feedUrl;
+    }
+    if (icon != null) {
+      $result.icon = icon;
+    }
+    if (favicon != null) {
+      $result.favicon = favicon;
+    }
+    if (nextUrl != null) {
+      $result.nextUrl = nextUrl;
+    }
+    if (language != null) {
+      $result.language = language;
+    }
+    if (expired != null) {
+      $result.expired = expired;
+    }
+    if (authors != null) {
+      $result.authors.addAll(authors);
+    }
+    return $result;
+  }
+  JsonFeedChannel._() : super();
+  factory JsonFeedChannel.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory JsonFeedChannel.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'JsonFeedChannel',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'homePageUrl')
+    ..aOS(2, 'feedUrl')
+    ..aOS(3, 'icon')
+    ..aOS(4, 'favicon')
+    ..aOS(5, 'nextUrl')
+    ..aOS(6, 'language')
+    ..aOB(7, 'expired')
+    ..pc<Author>(8, 'authors', $pb.PbFieldType.PM, subBuilder: Author.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  JsonFeedChannel clone() => JsonFeedChannel()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  JsonFeedChannel copyWith(void Function(JsonFeedChannel) updates) =>
+      super.copyWith((message) => updates(message as JsonFeedChannel)) as JsonFeedChannel;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static JsonFeedChannel create() => JsonFeedChannel._();
+  JsonFeedChannel createEmptyInstance() => create();
+  static $pb.PbList<JsonFeedChannel> createRepeated() => $pb.PbList<JsonFeedChannel>();
+  @$core.pragma('dart2js:noInline')
+  static JsonFeedChannel getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<JsonFeedChannel>(create);
+  static JsonFeedChannel? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get homePageUrl => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set homePageUrl($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasHomePageUrl() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHomePageUrl() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get feedUrl => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set feedUrl($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasFeedUrl() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearFeedUrl() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get icon => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set icon($core.String v) {
+    $_setString(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasIcon() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearIcon() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get favicon => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set favicon($core.String v) {
+    $_setString(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasFavicon() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearFavicon() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.String get nextUrl => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set nextUrl($core.String v) {
+    $_setString(4, v);
+  }
+
+  @$pb.TagNumber(5)
+  $core.bool hasNextUrl() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearNextUrl() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.String get language => $_getSZ(5);
+  @$pb.TagNumber(6)
+  set language($core.String v) {
+    $_setString(5, v);
+  }
+
+  @$pb.TagNumber(6)
+  $core.bool hasLanguage() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearLanguage() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.bool get expired => $_getBF(6);
+  @$pb.TagNumber(7)
+  set expired($core.bool v) {
+    $_setBool(6, v);
+  }
+
+  @$pb.TagNumber(7)
+  $core.bool hasExpired() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearExpired() => clearField(7);
+
+  @$pb.TagNumber(8)
+  $core.List<Author> get authors => $_getList(7);
+}
+
+class Author extends $pb.GeneratedMessage {
+  factory Author({
+    $core.String? name,
+    $core.String? url,
+    $core.String? avatar,
+  }) {
+    final $result = create();
+    if (name != null) {
+      $result.name = name;
+    }
+    if (url != null) {
+      $result.url = url;
+    }
+    if (avatar != null) {
+      $result.avatar = avatar;
+    }
+    return $result;
+  }
+  Author._() : super();
+  factory Author.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory Author.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'Author',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'name')
+    ..aOS(2, 'url')
+    ..aOS(3, 'avatar')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  Author clone() => Author()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  Author copyWith(void Function(Author) updates) =>
+      super.copyWith((message) => updates(message as Author)) as Author;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static Author create() => Author._();
+  Author createEmptyInstance() => create();
+  static $pb.PbList<Author> createRepeated() => $pb.PbList<Author>();
+  @$core.pragma('dart2js:noInline')
+  static Author getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<Author>(create);
+  static Author? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get name => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set name($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasName() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearName() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get url => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set url($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasUrl() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearUrl() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get avatar => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set avatar($core.String v) {
+    $_setString(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasAvatar() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearAvatar() => clearField(3);
+}
+
+class JsonFeedItem extends $pb.GeneratedMessage {
+  factory JsonFeedItem({
+    $core.String? contentHtml,
+    $core.String? contentText,
+    $core.String? summary,
+    $core.String? bannerImage,
+    $core.String? externalUrl,
+    $core.String? dateModified,
+    $core.Iterable<Author>? authors,
+    $core.Iterable<$core.String>? tags,
+    $core.Iterable<Attachment>? attachments,
+    $core.String? language,
+  }) {
+    final $result = create();
+    if (contentHtml != null) {
+      $result.contentHtml = contentHtml;
+    }
+    if (contentText != null) {
+      $result.contentText = contentText;
+    }
+    if (summary != null) {
+      $result.summary = summary;
+    }
+    if (bannerImage != null) {
+      $result.bannerImage = bannerImage;
+    }
+    if (externalUrl != null) {
+      $result.externalUrl = externalUrl;
+    }
+    if (dateModified != null) {
+      $result.dateModified = dateModified;
+    }
+    if (authors != null) {
+      $result.authors.addAll(authors);
+    }
+    if (tags != null) {
+      $result.tags.addAll(tags);
+    }
+    if (attachments != null) {
+      $result.attachments.addAll(attachments);
+    }
+    if (language != null) {
+      $result.language = language;
+    }
+    return $result;
+  }
+  JsonFeedItem._() : super();
+  factory JsonFeedItem.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory JsonFeedItem.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'JsonFeedItem',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'contentHtml')
+    ..aOS(2, 'contentText')
+    ..aOS(3, 'summary')
+    ..aOS(4, 'bannerImage')
+    ..aOS(5, 'externalUrl')
+    ..aOS(6, 'dateModified')
+    ..pc<Author>(7, 'authors', $pb.PbFieldType.PM, subBuilder: Author.create)
+    ..pPS(8, 'tags')
+    ..pc<Attachment>(9, 'attachments', $pb.PbFieldType.PM, subBuilder: Attachment.create)
+    ..aOS(10, 'language')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  JsonFeedItem clone() => JsonFeedItem()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  JsonFeedItem copyWith(void Function(JsonFeedItem) updates) =>
+      super.copyWith((message) => updates(message as JsonFeedItem)) as JsonFeedItem;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static JsonFeedItem create() => JsonFeedItem._();
+  JsonFeedItem createEmptyInstance() => create();
+  static $pb.PbList<JsonFeedItem> createRepeated() => $pb.PbList<JsonFeedItem>();
+  @$core.pragma('dart2js:noInline')
+  static JsonFeedItem getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<JsonFeedItem>(create);
+  static JsonFeedItem? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get contentHtml => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set contentHtml($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasContentHtml() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearContentHtml() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get contentText => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set contentText($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasContentText() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearContentText() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get summary => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set summary($core.String v) {
+    $_setString(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasSummary() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearSummary() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get bannerImage => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set bannerImage($core.String v) {
+    $_setString(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasBannerImage() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearBannerImage() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.String get externalUrl => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set externalUrl($core.String v) {
+    $_setString(4, v);
+  }
+
+  @$pb.TagNumber(5)
+  $core.bool hasExternalUrl() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearExternalUrl() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.String get dateModified => $_getSZ(5);
+  @$pb.TagNumber(6)
+  set dateModified($core.String v) {
+    $_setString(5, v);
+  }
+
+  @$pb.TagNumber(6)
+  $core.bool hasDateModified() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearDateModified() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.List<Author> get authors => $_getList(6);
+
+  @$pb.TagNumber(8)
+  $core.List<$core.String> get tags => $_getList(7);
+
+  @$pb.TagNumber(9)
+  $core.List<Attachment> get attachments => $_getList(8);
+
+  @$pb.TagNumber(10)
+  $core.String get language => $_getSZ(9);
+  @$pb.TagNumber(10)
+  set language($core.String v) {
+    $_setString(9, v);
+  }
+
+  @$pb.TagNumber(10)
+  $core.bool hasLanguage() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearLanguage() => clearField(10);
+}
+
+class Attachment extends $pb.GeneratedMessage {
+  factory Attachment({
+    $core.String? url,
+    $core.String? mimeType,
+    $core.String? title,
+    $fixnum.Int64? sizeInBytes,
+    $fixnum.Int64? durationSeconds,
+  }) {
+    final $result = create();
+    if (url != null) {
+      $result.url = url;
+    }
+    if (mimeType != null) {
+      $result.mimeType = mimeType;
+    }
+    if (title != null) {
+      $result.title = title;
+    }
+    if (sizeInBytes != null) {
+      $result.sizeInBytes = sizeInBytes;
+    }
+    if (durationSeconds != null) {
+      $result.durationSeconds = durationSeconds;
+    }
+    return $result;
+  }
+  Attachment._() : super();
+  factory Attachment.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory Attachment.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'Attachment',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'url')
+    ..aOS(2, 'mimeType')
+    ..aOS(3, 'title')
+    ..aInt64(4, 'sizeInBytes')
+    ..aInt64(5, 'durationSeconds')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  Attachment clone() => Attachment()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  Attachment copyWith(void Function(Attachment) updates) =>
+      super.copyWith((message) => updates(message as Attachment)) as Attachment;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static Attachment create() => Attachment._();
+  Attachment createEmptyInstance() => create();
+  static $pb.PbList<Attachment> createRepeated() => $pb.PbList<Attachment>();
+  @$core.pragma('dart2js:noInline')
+  static Attachment getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<Attachment>(create);
+  static Attachment? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get url => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set url($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasUrl() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUrl() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get mimeType => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set mimeType($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasMimeType() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearMimeType() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get title => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set title($core.String v) {
+    $_setString(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasTitle() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearTitle() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get sizeInBytes => $_getI64(3);
+  @$pb.TagNumber(4)
+  set sizeInBytes($fixnum.Int64 v) {
+    $_setInt64(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasSizeInBytes() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearSizeInBytes() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get durationSeconds => $_getI64(4);
+  @$pb.TagNumber(5)
+  set durationSeconds($fixnum.Int64 v) {
+    $_setInt64(4, v);
+  }
+
+  @$pb.TagNumber(5)
+  $core.bool hasDurationSeconds() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearDurationSeconds() => clearField(5);
+}
+
+class ResolveIconRequest extends $pb.GeneratedMessage {
+  factory ResolveIconRequest({
+    $core.String? url,
+    $core.String? requestId,
+    $core.bool? includeData,
+    $fixnum.Int64? maxBytes,
+  }) {
+    final $result = create();
+    if (url != null) {
+      $result.url = url;
+    }
+    if (requestId != null) {
+      $result.requestId = requestId;
+    }
+    if (includeData != null) {
+      $result.includeData = includeData;
+    }
+    if (maxBytes != null) {
+      $result.maxBytes = maxBytes;
+    }
+    return $result;
+  }
+  ResolveIconRequest._() : super();
+  factory ResolveIconRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory ResolveIconRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'ResolveIconRequest',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'url')
+    ..aOS(2, 'requestId')
+    ..aOB(3, 'includeData')
+    ..aInt64(4, 'maxBytes')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  ResolveIconRequest clone() => ResolveIconRequest()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  ResolveIconRequest copyWith(void Function(ResolveIconRequest) updates) =>
+      super.copyWith((message) => updates(message as ResolveIconRequest)) as ResolveIconRequest;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ResolveIconRequest create() => ResolveIconRequest._();
+  ResolveIconRequest createEmptyInstance() => create();
+  static $pb.PbList<ResolveIconRequest> createRepeated() => $pb.PbList<ResolveIconRequest>();
+  @$core.pragma('dart2js:noInline')
+  static ResolveIconRequest getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ResolveIconRequest>(create);
+  static ResolveIconRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get url => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set url($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasUrl() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUrl() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get requestId => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set requestId($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasRequestId() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearRequestId() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.bool get includeData => $_getBF(2);
+  @$pb.TagNumber(3)
+  set includeData($core.bool v) {
+    $_setBool(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasIncludeData() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearIncludeData() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get maxBytes => $_getI64(3);
+  @$pb.TagNumber(4)
+  set maxBytes($fixnum.Int64 v) {
+    $_setInt64(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasMaxBytes() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearMaxBytes() => clearField(4);
+}
+
+class ResolveIconResponse extends $pb.GeneratedMessage {
+  factory ResolveIconResponse({
+    FeedIcon? icon,
+    $core.Iterable<FeedIcon>? candidates,
+    ErrorDetail? error,
+  }) {
+    final $result = create();
+    if (icon != null) {
+      $result.icon = icon;
+    }
+    if (candidates != null) {
+      $result.candidates.addAll(candidates);
+    }
+    if (error != null) {
+      $result.error = error;
+    }
+    return $result;
+  }
+  ResolveIconResponse._() : super();
+  factory ResolveIconResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory ResolveIconResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'ResolveIconResponse',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOM<FeedIcon>(1, 'icon', subBuilder: FeedIcon.create)
+    ..pc<FeedIcon>(2, 'candidates', $pb.PbFieldType.PM, subBuilder: FeedIcon.create)
+    ..aOM<ErrorDetail>(3, 'error', subBuilder: ErrorDetail.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  ResolveIconResponse clone() => ResolveIconResponse()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  ResolveIconResponse copyWith(void Function(ResolveIconResponse) updates) =>
+      super.copyWith((message) => updates(message as ResolveIconResponse)) as ResolveIconResponse;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ResolveIconResponse create() => ResolveIconResponse._();
+  ResolveIconResponse createEmptyInstance() => create();
+  static $pb.PbList<ResolveIconResponse> createRepeated() => $pb.PbList<ResolveIconResponse>();
+  @$core.pragma('dart2js:noInline')
+  static ResolveIconResponse getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ResolveIconResponse>(create);
+  static ResolveIconResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  FeedIcon get icon => $_getN(0);
+  @$pb.TagNumber(1)
+  set icon(FeedIcon v) {
+    setField(1, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasIcon() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearIcon() => clearField(1);
+  @$pb.TagNumber(1)
+  FeedIcon ensureIcon() => $_ensure(0);
+
+  @$pb.TagNumber(2)
+  $core.List<FeedIcon> get candidates => $_getList(1);
+
+  @$pb.TagNumber(3)
+  ErrorDetail get error => $_getN(2);
+  @$pb.TagNumber(3)
+  set error(ErrorDetail v) {
+    setField(3, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasError() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearError() => clearField(3);
+  @$pb.TagNumber(3)
+  ErrorDetail ensureError() => $_ensure(2);
+}
+
+class FeedIcon extends $pb.GeneratedMessage {
+  factory FeedIcon({
+    $core.String? url,
+    $core.String? mimeType,
+    $core.int? width,
+    $core.int? height,
+    IconSource? source,
+    $core.List<$core.int>? data,
+    $fixnum.Int64? byteSize,
+  }) {
+    final $result = create();
+    if (url != null) {
+      $result.url = url;
+    }
+    if (mimeType != null) {
+      $result.mimeType = mimeType;
+    }
+    if (width != null) {
+      $result.width = width;
+    }
+    if (height != null) {
+      $result.height = height;
+    }
+    if (source != null) {
+      $result.source = source;
+    }
+    if (data != null) {
+      $result.data = data;
+    }
+    if (byteSize != null) {
+      $result.byteSize = byteSize;
+    }
+    return $result;
+  }
+  FeedIcon._() : super();
+  factory FeedIcon.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory FeedIcon.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'FeedIcon',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'url')
+    ..aOS(2, 'mimeType')
+    ..a<$core.int>(3, 'width', $pb.PbFieldType.O3)
+    ..a<$core.int>(4, 'height', $pb.PbFieldType.O3)
+    ..e<IconSource>(
+      5,
+      'source',
+      $pb.PbFieldType.OE,
+      defaultOrMaker: IconSource.ICON_SOURCE_UNKNOWN,
+      valueOf: IconSource.valueOf,
+      enumValues: IconSource.values,
+    )
+    ..a<$core.List<$core.int>>(6, 'data', $pb.PbFieldType.OY)
+    ..aInt64(7, 'byteSize')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  FeedIcon clone() => FeedIcon()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  FeedIcon copyWith(void Function(FeedIcon) updates) =>
+      super.copyWith((message) => updates(message as FeedIcon)) as FeedIcon;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static FeedIcon create() => FeedIcon._();
+  FeedIcon createEmptyInstance() => create();
+  static $pb.PbList<FeedIcon> createRepeated() => $pb.PbList<FeedIcon>();
+  @$core.pragma('dart2js:noInline')
+  static FeedIcon getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<FeedIcon>(create);
+  static FeedIcon? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get url => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set url($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasUrl() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUrl() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get mimeType => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set mimeType($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasMimeType() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearMimeType() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get width => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set width($core.int v) {
+    $_setSignedInt32(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasWidth() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearWidth() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get height => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set height($core.int v) {
+    $_setSignedInt32(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasHeight() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearHeight() => clearField(4);
+
+  @$pb.TagNumber(5)
+  IconSource get source => $_getN(4);
+  @$pb.TagNumber(5)
+  set source(IconSource v) {
+    setField(5, v);
+  }
+
+  @$pb.TagNumber(5)
+  $core.bool hasSource() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearSource() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.List<$core.int> get data => $_getN(5);
+  @$pb.TagNumber(6)
+  set data($core.List<$core.int> v) {
+    $_setBytes(5, v);
+  }
+
+  @$pb.TagNumber(6)
+  $core.bool hasData() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearData() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $fixnum.Int64 get byteSize => $_getI64(6);
+  @$pb.TagNumber(7)
+  set byteSize($fixnum.Int64 v) {
+    $_setInt64(6, v);
+  }
+
+  @$pb.TagNumber(7)
+  $core.bool hasByteSize() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearByteSize() => clearField(7);
+}
+
+class CacheRequest extends $pb.GeneratedMessage {
+  factory CacheRequest({
+    CacheAction? action,
+    $core.Iterable<$core.String>? urls,
+  }) {
+    final $result = create();
+    if (action != null) {
+      $result.action = action;
+    }
+    if (urls != null) {
+      $result.urls.addAll(urls);
+    }
+    return $result;
+  }
+  CacheRequest._() : super();
+  factory CacheRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory CacheRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'CacheRequest',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..e<CacheAction>(
+      1,
+      'action',
+      $pb.PbFieldType.OE,
+      defaultOrMaker: CacheAction.CACHE_ACTION_INSPECT,
+      valueOf: CacheAction.valueOf,
+      enumValues: CacheAction.values,
+    )
+    ..pPS(2, 'urls')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  CacheRequest clone() => CacheRequest()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  CacheRequest copyWith(void Function(CacheRequest) updates) =>
+      super.copyWith((message) => updates(message as CacheRequest)) as CacheRequest;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static CacheRequest create() => CacheRequest._();
+  CacheRequest createEmptyInstance() => create();
+  static $pb.PbList<CacheRequest> createRepeated() => $pb.PbList<CacheRequest>();
+  @$core.pragma('dart2js:noInline')
+  static CacheRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CacheRequest>(create);
+  static CacheRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  CacheAction get action => $_getN(0);
+  @$pb.TagNumber(1)
+  set action(CacheAction v) {
+    setField(1, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasAction() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearAction() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<$core.String> get urls => $_getList(1);
+}
+
+class CacheResponse extends $pb.GeneratedMessage {
+  factory CacheResponse({
+    $core.Iterable<CacheEntry>? entries,
+    $fixnum.Int64? totalBytes,
+    $fixnum.Int64? maxBytes,
+    $fixnum.Int64? ttlMs,
+    $core.int? cleared,
+    ErrorDetail? error,
+  }) {
+    final $result = create();
+    if (entries != null) {
+      $result.entries.addAll(entries);
+    }
+    if (totalBytes != null) {
+      $result.totalBytes = totalBytes;
+    }
+    if (maxBytes != null) {
+      $result.maxBytes = maxBytes;
+    }
+    if (ttlMs != null) {
+      $result.ttlMs = ttlMs;
+    }
+    if (cleared != null) {
+      $result.cleared = cleared;
+    }
+    if (error != null) {
+      $result.error = error;
+    }
+    return $result;
+  }
+  CacheResponse._() : super();
+  factory CacheResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory CacheResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'CacheResponse',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..pc<CacheEntry>(1, 'entries', $pb.PbFieldType.PM, subBuilder: CacheEntry.create)
+    ..aInt64(2, 'totalBytes')
+    ..aInt64(3, 'maxBytes')
+    ..aInt64(4, 'ttlMs')
+    ..a<$core.int>(5, 'cleared', $pb.PbFieldType.O3)
+    ..aOM<ErrorDetail>(6, 'error', subBuilder: ErrorDetail.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  CacheResponse clone() => CacheResponse()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  CacheResponse copyWith(void Function(CacheResponse) updates) =>
+      super.copyWith((message) => updates(message as CacheResponse)) as CacheResponse;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static CacheResponse create() => CacheResponse._();
+  CacheResponse createEmptyInstance() => create();
+  static $pb.PbList<CacheResponse> createRepeated() => $pb.PbList<CacheResponse>();
+  @$core.pragma('dart2js:noInline')
+  static CacheResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CacheResponse>(create);
+  static CacheResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<CacheEntry> get entries => $_getList(0);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get totalBytes => $_getI64(1);
+  @$pb.TagNumber(2)
+  set totalBytes($fixnum.Int64 v) {
+    $_setInt64(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasTotalBytes() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearTotalBytes() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get maxBytes => $_getI64(2);
+  @$pb.TagNumber(3)
+  set maxBytes($fixnum.Int64 v) {
+    $_setInt64(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasMaxBytes() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearMaxBytes() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get ttlMs => $_getI64(3);
+  @$pb.TagNumber(4)
+  set ttlMs($fixnum.Int64 v) {
+    $_setInt64(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasTtlMs() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearTtlMs() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.int get cleared => $_getIZ(4);
+  @$pb.TagNumber(5)
+  set cleared($core.int v) {
+    $_setSignedInt32(4, v);
+  }
+
+  @$pb.TagNumber(5)
+  $core.bool hasCleared() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearCleared() => clearField(5);
+
+  @$pb.TagNumber(6)
+  ErrorDetail get error => $_getN(5);
+  @$pb.TagNumber(6)
+  set error(ErrorDetail v) {
+    setField(6, v);
+  }
+
+  @$pb.TagNumber(6)
+  $core.bool hasError() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearError() => clearField(6);
+  @$pb.TagNumber(6)
+  ErrorDetail ensureError() => $_ensure(5);
+}
+
+class CacheEntry extends $pb.GeneratedMessage {
+  factory CacheEntry({
+    $core.String? url,
+    $fixnum.Int64? sizeBytes,
+    $fixnum.Int64? ageMs,
+    $fixnum.Int64? expiresInMs,
+  }) {
+    final $result = create();
+    if (url != null) {
+      $result.url = url;
+    }
+    if (sizeBytes != null) {
+      $result.sizeBytes = sizeBytes;
+    }
+    if (ageMs != null) {
+      $result.ageMs = ageMs;
+    }
+    if (expiresInMs != null) {
+      $result.expiresInMs = expiresInMs;
+    }
+    return $result;
+  }
+  CacheEntry._() : super();
+  factory CacheEntry.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory CacheEntry.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'CacheEntry',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'url')
+    ..aInt64(2, 'sizeBytes')
+    ..aInt64(3, 'ageMs')
+    ..aInt64(4, 'expiresInMs')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  CacheEntry clone() => CacheEntry()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  CacheEntry copyWith(void Function(CacheEntry) updates) =>
+      super.copyWith((message) => updates(message as CacheEntry)) as CacheEntry;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static CacheEntry create() => CacheEntry._();
+  CacheEntry createEmptyInstance() => create();
+  static $pb.PbList<CacheEntry> createRepeated() => $pb.PbList<CacheEntry>();
+  @$core.pragma('dart2js:noInline')
+  static CacheEntry getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CacheEntry>(create);
+  static CacheEntry? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get url => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set url($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasUrl() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearUrl() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get sizeBytes => $_getI64(1);
+  @$pb.TagNumber(2)
+  set sizeBytes($fixnum.Int64 v) {
+    $_setInt64(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasSizeBytes() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearSizeBytes() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get ageMs => $_getI64(2);
+  @$pb.TagNumber(3)
+  set ageMs($fixnum.Int64 v) {
+    $_setInt64(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasAgeMs() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearAgeMs() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get expiresInMs => $_getI64(3);
+  @$pb.TagNumber(4)
+  set expiresInMs($fixnum.Int64 v) {
+    $_setInt64(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasExpiresInMs() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearExpiresInMs() => clearField(4);
+}
+
+class FeedLimits extends $pb.GeneratedMessage {
+  factory FeedLimits({
+    $fixnum.Int64? maxBodyBytes,
+    $core.int? maxItems,
+    $core.int? maxTitleLength,
+    $core.int? maxDescriptionLength,
+    $core.int? maxContentLength,
+  }) {
+    final $result = create();
+    if (maxBodyBytes != null) {
+      $result.maxBodyBytes = maxBodyBytes;
+    }
+    if (maxItems != null) {
+      $result.maxItems = maxItems;
+    }
+    if (maxTitleLength != null) {
+      $result.maxTitleLength = maxTitleLength;
+    }
+    if (maxDescriptionLength != null) {
+      $result.maxDescriptionLength = maxDescriptionLength;
+    }
+    if (maxContentLength != null) {
+      $result.maxContentLength = maxContentLength;
+    }
+    return $result;
+  }
+  FeedLimits._() : super();
+  factory FeedLimits.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory FeedLimits.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'FeedLimits',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aInt64(1, 'maxBodyBytes')
+    ..a<$core.int>(2, 'maxItems', $pb.PbFieldType.O3)
+    ..a<$core.int>(3, 'maxTitleLength', $pb.PbFieldType.O3)
+    ..a<$core.int>(4, 'maxDescriptionLength', $pb.PbFieldType.O3)
+    ..a<$core.int>(5, 'maxContentLength', $pb.PbFieldType.O3)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  FeedLimits clone() => FeedLimits()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  FeedLimits copyWith(void Function(FeedLimits) updates) =>
+      super.copyWith((message) => updates(message as FeedLimits)) as FeedLimits;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static FeedLimits create() => FeedLimits._();
+  FeedLimits createEmptyInstance() => create();
+  static $pb.PbList<FeedLimits> createRepeated() => $pb.PbList<FeedLimits>();
+  @$core.pragma('dart2js:noInline')
+  static FeedLimits getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<FeedLimits>(create);
+  static FeedLimits? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get maxBodyBytes => $_getI64(0);
+  @$pb.TagNumber(1)
+  set maxBodyBytes($fixnum.Int64 v) {
+    $_setInt64(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasMaxBodyBytes() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearMaxBodyBytes() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.int get maxItems => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set maxItems($core.int v) {
+    $_setSignedInt32(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasMaxItems() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearMaxItems() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get maxTitleLength => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set maxTitleLength($core.int v) {
+    $_setSignedInt32(2, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasMaxTitleLength() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearMaxTitleLength() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get maxDescriptionLength => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set maxDescriptionLength($core.int v) {
+    $_setSignedInt32(3, v);
+  }
+
+  @$pb.TagNumber(4)
+  $core.bool hasMaxDescriptionLength() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearMaxDescriptionLength() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.int get maxContentLength => $_getIZ(4);
+  @$pb.TagNumber(5)
+  set maxContentLength($core.int v) {
+    $_setSignedInt32(4, v);
+  }
+
+  @$pb.TagNumber(5)
+  $core.bool hasMaxContentLength() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearMaxContentLength() => clearField(5);
+}
+
+class NetworkPolicy extends $pb.GeneratedMessage {
+  factory NetworkPolicy({
+    $core.bool? enabled,
+    $core.Iterable<$core.int>? allowedPorts,
+  }) {
+    final $result = create();
+    if (enabled != null) {
+      $result.enabled = enabled;
+    }
+    if (allowedPorts != null) {
+      $result.allowedPorts.addAll(allowedPorts);
+    }
+    return $result;
+  }
+  NetworkPolicy._() : super();
+  factory NetworkPolicy.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory NetworkPolicy.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'NetworkPolicy',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOB(1, 'enabled')
+    ..p<$core.int>(2, 'allowedPorts', $pb.PbFieldType.P3)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  NetworkPolicy clone() => NetworkPolicy()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  NetworkPolicy copyWith(void Function(NetworkPolicy) updates) =>
+      super.copyWith((message) => updates(message as NetworkPolicy)) as NetworkPolicy;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static NetworkPolicy create() => NetworkPolicy._();
+  NetworkPolicy createEmptyInstance() => create();
+  static $pb.PbList<NetworkPolicy> createRepeated() => $pb.PbList<NetworkPolicy>();
+  @$core.pragma('dart2js:noInline')
+  static NetworkPolicy getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<NetworkPolicy>(create);
+  static NetworkPolicy? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.bool get enabled => $_getBF(0);
+  @$pb.TagNumber(1)
+  set enabled($core.bool v) {
+    $_setBool(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasEnabled() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearEnabled() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<$core.int> get allowedPorts => $_getList(1);
+}
+
+class NormalizeUrlsRequest extends $pb.GeneratedMessage {
+  factory NormalizeUrlsRequest({
+    $core.Iterable<$core.String>? urls,
+    $core.bool? stripTrackingParams,
+  }) {
+    final $result = create();
+    if (urls != null) {
+      $result.urls.addAll(urls);
+    }
+    if (stripTrackingParams != null) {
+      $result.stripTrackingParams = stripTrackingParams;
+    }
+    return $result;
+  }
+  NormalizeUrlsRequest._() : super();
+  factory NormalizeUrlsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory NormalizeUrlsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'NormalizeUrlsRequest',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..pPS(1, 'urls')
+    ..aOB(2, 'stripTrackingParams')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  NormalizeUrlsRequest clone() => NormalizeUrlsRequest()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  NormalizeUrlsRequest copyWith(void Function(NormalizeUrlsRequest) updates) =>
+      super.copyWith((message) => updates(message as NormalizeUrlsRequest)) as NormalizeUrlsRequest;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static NormalizeUrlsRequest create() => NormalizeUrlsRequest._();
+  NormalizeUrlsRequest createEmptyInstance() => create();
+  static $pb.PbList<NormalizeUrlsRequest> createRepeated() => $pb.PbList<NormalizeUrlsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static NormalizeUrlsRequest getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<NormalizeUrlsRequest>(create);
+  static NormalizeUrlsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<$core.String> get urls => $_getList(0);
+
+  @$pb.TagNumber(2)
+  $core.bool get stripTrackingParams => $_getBF(1);
+  @$pb.TagNumber(2)
+  set stripTrackingParams($core.bool v) {
+    $_setBool(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasStripTrackingParams() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearStripTrackingParams() => clearField(2);
+}
+
+class NormalizeUrlsResponse extends $pb.GeneratedMessage {
+  factory NormalizeUrlsResponse({
+    $core.Iterable<NormalizedUrl>? urls,
+    ErrorDetail? error,
+  }) {
+    final $result = create();
+    if (urls != null) {
+      $result.urls.addAll(urls);
+    }
+    if (error != null) {
+      $result.error = error;
+    }
+    return $result;
+  }
+  NormalizeUrlsResponse._() : super();
+  factory NormalizeUrlsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory NormalizeUrlsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'NormalizeUrlsResponse',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..pc<NormalizedUrl>(1, 'urls', $pb.PbFieldType.PM, subBuilder: NormalizedUrl.create)
+    ..aOM<ErrorDetail>(2, 'error', subBuilder: ErrorDetail.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  NormalizeUrlsResponse clone() => NormalizeUrlsResponse()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  NormalizeUrlsResponse copyWith(void Function(NormalizeUrlsResponse) updates) =>
+      super.copyWith((message) => updates(message as NormalizeUrlsResponse)) as NormalizeUrlsResponse;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static NormalizeUrlsResponse create() => NormalizeUrlsResponse._();
+  NormalizeUrlsResponse createEmptyInstance() => create();
+  static $pb.PbList<NormalizeUrlsResponse> createRepeated() => $pb.PbList<NormalizeUrlsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static NormalizeUrlsResponse getDefault() =>
+      _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<NormalizeUrlsResponse>(create);
+  static NormalizeUrlsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<NormalizedUrl> get urls => $_getList(0);
+
+  @$pb.TagNumber(2)
+  ErrorDetail get error => $_getN(1);
+  @$pb.TagNumber(2)
+  set error(ErrorDetail v) {
+    setField(2, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasError() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearError() => clearField(2);
+  @$pb.TagNumber(2)
+  ErrorDetail ensureError() => $_ensure(1);
+}
+
+class NormalizedUrl extends $pb.GeneratedMessage {
+  factory NormalizedUrl({
+    $core.String? input,
+    $core.String? canonical,
+    ErrorDetail? error,
+  }) {
+    final $result = create();
+    if (input != null) {
+      $result.input = input;
+    }
+    if (canonical != null) {
+      $result.canonical = canonical;
+    }
+    if (error != null) {
+      $result.error = error;
+    }
+    return $result;
+  }
+  NormalizedUrl._() : super();
+  factory NormalizedUrl.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(i, r);
+  factory NormalizedUrl.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(i, r);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+    'NormalizedUrl',
+    package: const $pb.PackageName('proto'),
+    createEmptyInstance: create,
+  )
+    ..aOS(1, 'input')
+    ..aOS(2, 'canonical')
+    ..aOM<ErrorDetail>(3, 'error', subBuilder: ErrorDetail.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('Use deepCopy instead. Will be removed in next major version')
+  NormalizedUrl clone() => NormalizedUrl()..mergeFromMessage(this);
+  @$core.Deprecated('Use rebuild instead. Will be removed in next major version')
+  NormalizedUrl copyWith(void Function(NormalizedUrl) updates) =>
+      super.copyWith((message) => updates(message as NormalizedUrl)) as NormalizedUrl;
+
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static NormalizedUrl create() => NormalizedUrl._();
+  NormalizedUrl createEmptyInstance() => create();
+  static $pb.PbList<NormalizedUrl> createRepeated() => $pb.PbList<NormalizedUrl>();
+  @$core.pragma('dart2js:noInline')
+  static NormalizedUrl getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<NormalizedUrl>(create);
+  static NormalizedUrl? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get input => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set input($core.String v) {
+    $_setString(0, v);
+  }
+
+  @$pb.TagNumber(1)
+  $core.bool hasInput() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearInput() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get canonical => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set canonical($core.String v) {
+    $_setString(1, v);
+  }
+
+  @$pb.TagNumber(2)
+  $core.bool hasCanonical() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearCanonical() => clearField(2);
+
+  @$pb.TagNumber(3)
+  ErrorDetail get error => $_getN(2);
+  @$pb.TagNumber(3)
+  set error(ErrorDetail v) {
+    setField(3, v);
+  }
+
+  @$pb.TagNumber(3)
+  $core.bool hasError() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearError() => clearField(3);
+  @$pb.TagNumber(3)
+  ErrorDetail ensureError() => $_ensure(2);
+}
@@ -10,17 +10,75 @@ import 'package:rss_it_library/protos/feed.pb.dart';
 import 'rss_it_library_bindings_generated.dart';
 
 /// Validates a feed URL via the native Go implementation.
+/// Pass a [requestId] to be able to abort the validation with [cancelRequest].
 /// Throws [RssItLibraryException] when the native side reports a structured error.
-Future<bool> validateFeedURL(String url) =>
-    Isolate.run<bool>(() => _validateSync(url));
+Future<bool> validateFeedURL(String url, {String? requestId}) =>
+    Isolate.run<bool>(() => _validateSync(url, requestId));
 
 /// Parses one or more feed URLs using the Go runtime with concurrency and sanitisation.
+/// Pass a [requestId] to be able to abort the parse with [cancelRequest].
 /// Throws [RssItLibraryException] when a fatal (non-partial) error occurs.
-Future<ParseFeedsResponse> parseFeedURLs(List<String> urls) =>
-    Isolate.run<ParseFeedsResponse>(() => _parseSync(urls));
+Future<ParseFeedsResponse> parseFeedURLs(
+  List<String> urls, {
+  String? requestId,
+}) => Isolate.run<ParseFeedsResponse>(() => _parseSync(urls, requestId));
+
+/// Parses feed URLs like [parseFeedURLs], but emits a [ParseStreamEvent] for each [Feed] or per-URL [ErrorDetail]
+/// as soon as it completes, followed by a [ParseStreamSummary] that closes the stream.
+/// Cancelling the subscription aborts the remaining work.
+Stream<ParseStreamEvent> parseFeedURLsStream(
+  List<String> urls, {
+  String? requestId,
+}) {
+  final id =
+      requestId ??
+      'parse-stream-${DateTime.now().microsecondsSinceEpoch}-${_nextStreamId++}';
+  final controller = StreamController<ParseStreamEvent>();
+
+  // Events arrive on Go-owned threads; a listener callable forwards them to this isolate.
+  late final ffi.NativeCallable<parse_event_callbackFunction> callback;
+  callback = ffi.NativeCallable<parse_event_callbackFunction>.listener((
+    ffi.Pointer<ffi.Char> event,
+  ) {
+    final response = ParseStreamEvent.fromBuffer(_takeResponseBytes(event));
+    controller.add(response);
+    // The summary is always the last event, so the callable can be released once it arrives.
+    if (response.whichEvent() == ParseStreamEvent_Event.summary) {
+      callback.close();
+      controller.close();
+    }
+  });
+  controller.onCancel = () {
+    cancelRequest(id);
+  };
+
+  final request = ParseFeedsRequest(urls: urls, requestId: id);
+  final buffer = request.writeToBuffer();
+  final ffi.Pointer<ffi.Char> cRequest = malloc<ffi.Char>(buffer.length);
+  try {
+    cRequest.cast<ffi.Uint8>().asTypedList(buffer.length).setAll(0, buffer);
+    _bindings.parseStream(cRequest, buffer.length, callback.nativeFunction);
+  } finally {
+    malloc.free(cRequest);
+  }
+  return controller.stream;
+}
+
+/// Distinguishes the request IDs generated for streams started without one.
+int _nextStreamId = 0;
+
+/// Aborts the in-flight validation or parse started with [requestId], returning whether one was found.
+/// Work aborted this way is reported with `ERROR_KIND_CANCELLED`.
+bool cancelRequest(String requestId) {
+  final request = CancelRequest(requestId: requestId);
+  final buffer = request.writeToBuffer();
+  final responseBytes = _invokeNative(buffer, _bindings.cancel);
+
+  return CancelResponse.fromBuffer(responseBytes).cancelled;
+}
 
-bool _validateSync(String url) {
-  final request = ValidateFeedRequest(url: url);
+bool _validateSync(String url, String? requestId) {
+  final request = ValidateFeedRequest(url: url, requestId: requestId);
   final buffer = request.writeToBuffer();
   final responseBytes = _invokeNative(buffer, _bindings.validate);
 
@@ -31,8 +89,8 @@ bool _validateSync(String url) {
   return response.valid;
 }
 
-ParseFeedsResponse _parseSync(List<String> urls) {
-  final request = ParseFeedsRequest(urls: urls);
+ParseFeedsResponse _parseSync(List<String> urls, String? requestId) {
+  final request = ParseFeedsRequest(urls: urls, requestId: requestId);
   final buffer = request.writeToBuffer();
   final responseBytes = _invokeNative(buffer, _bindings.parse);
 
@@ -26,6 +26,19 @@ class RssItLibraryBindings {
     ffi.Pointer<T> Function<T extends ffi.NativeType>(String symbolName) lookup,
   ) : _lookup = lookup;
 
+  ffi.Pointer<ffi.Char> configure(ffi.Pointer<ffi.Char> data, int length) {
+    return _configure(data, length);
+  }
+
+  late final _configurePtr =
+      _lookup<
+        ffi.NativeFunction<
+          ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, ffi.Int)
+        >
+      >('configure');
+  late final _configure = _configurePtr
+      .asFunction<ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, int)>();
+
   ffi.Pointer<ffi.Char> validate(ffi.Pointer<ffi.Char> data, int length) {
     return _validate(data, length);
   }
@@ -52,6 +65,116 @@ class RssItLibraryBindings {
   late final _parse = _parsePtr
       .asFunction<ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, int)>();
 
+  void parseStream(
+    ffi.Pointer<ffi.Char> data,
+    int length,
+    parse_event_callback callback,
+  ) {
+    return _parseStream(data, length, callback);
+  }
+
+  late final _parseStreamPtr =
+      _lookup<
+        ffi.NativeFunction<
+          ffi.Void Function(ffi.Pointer<ffi.Char>, ffi.Int, parse_event_callback)
+        >
+      >('parse_stream');
+  late final _parseStream = _parseStreamPtr
+      .asFunction<
+        void Function(ffi.Pointer<ffi.Char>, int, parse_event_callback)
+      >();
+
+  ffi.Pointer<ffi.Char> discover(ffi.Pointer<ffi.Char> data, int length) {
+    return _discover(data, length);
+  }
+
+  late final _discoverPtr =
+      _lookup<
+        ffi.NativeFunction<
+          ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, ffi.Int)
+        >
+      >('discover');
+  late final _discover = _discoverPtr
+      .asFunction<ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, int)>();
+
+  ffi.Pointer<ffi.Char> resolveIcon(ffi.Pointer<ffi.Char> data, int length) {
+    return _resolveIcon(data, length);
+  }
+
+  late final _resolveIconPtr =
+      _lookup<
+        ffi.NativeFunction<
+          ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, ffi.Int)
+        >
+      >('resolve_icon');
+  late final _resolveIcon = _resolveIconPtr
+      .asFunction<ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, int)>();
+
+  ffi.Pointer<ffi.Char> importOpml(ffi.Pointer<ffi.Char> data, int length) {
+    return _importOpml(data, length);
+  }
+
+  late final _importOpmlPtr =
+      _lookup<
+        ffi.NativeFunction<
+          ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, ffi.Int)
+        >
+      >('import_opml');
+  late final _importOpml = _importOpmlPtr
+      .asFunction<ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, int)>();
+
+  ffi.Pointer<ffi.Char> exportOpml(ffi.Pointer<ffi.Char> data, int length) {
+    return _exportOpml(data, length);
+  }
+
+  late final _exportOpmlPtr =
+      _lookup<
+        ffi.NativeFunction<
+          ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, ffi.Int)
+        >
+      >('export_opml');
+  late final _exportOpml = _exportOpmlPtr
+      .asFunction<ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, int)>();
+
+  ffi.Pointer<ffi.Char> normalizeUrls(ffi.Pointer<ffi.Char> data, int length) {
+    return _normalizeUrls(data, length);
+  }
+
+  late final _normalizeUrlsPtr =
+      _lookup<
+        ffi.NativeFunction<
+          ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, ffi.Int)
+        >
+      >('normalize_urls');
+  late final _normalizeUrls = _normalizeUrlsPtr
+      .asFunction<ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, int)>();
+
+  ffi.Pointer<ffi.Char> cache(ffi.Pointer<ffi.Char> data, int length) {
+    return _cache(data, length);
+  }
+
+  late final _cachePtr =
+      _lookup<
+        ffi.NativeFunction<
+          ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, ffi.Int)
+        >
+      >('cache');
+  late final _cache = _cachePtr
+      .asFunction<ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, int)>();
+
+  ffi.Pointer<ffi.Char> cancel(ffi.Pointer<ffi.Char> data, int length) {
+    return _cancel(data, length);
+  }
+
+  late final _cancelPtr =
+      _lookup<
+        ffi.NativeFunction<
+          ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, ffi.Int)
+        >
+      >('cancel');
+  late final _cancel = _cancelPtr
+      .asFunction<ffi.Pointer<ffi.Char> Function(ffi.Pointer<ffi.Char>, int)>();
+
   void freeResult(ffi.Pointer<ffi.Char> data) {
     return _freeResult(data);
   }
@@ -65,3 +188,10 @@ class RssItLibraryBindings {
   late final _freeResult =
       _freeResultPtr.asFunction<void Function(ffi.Pointer<ffi.Char>)>();
 }
+
+typedef parse_event_callback =
+    ffi.Pointer<ffi.NativeFunction<parse_event_callbackFunction>>;
+typedef parse_event_callbackFunction =
+    ffi.Void Function(ffi.Pointer<ffi.Char> event);
+typedef Dartparse_event_callbackFunction =
+    void Function(ffi.Pointer<ffi.Char> event);
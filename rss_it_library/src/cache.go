@@ -0,0 +1,238 @@
+package main
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/sunderee/rss-it/proto"
+	goproto "google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultCacheTTL      = 5 * time.Minute
+	defaultCacheMaxBytes = 16 << 20
+)
+
+// FeedCache keeps recently parsed feeds in memory, keyed by URL, so repeated ParseFeeds calls within the TTL are
+// answered without touching the network. It is bounded by the total encoded size of its entries and evicts the
+// least recently used feeds first. A nil FeedCache never stores anything.
+type FeedCache struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	maxBytes int64
+	size     int64
+	order    *list.List
+	entries  map[string]*list.Element
+	now      func() time.Time
+}
+
+// cacheVariant captures the request options that change how a fetched feed is converted. A cached feed only
+// answers requests that would have produced the same output.
+type cacheVariant struct {
+	contentFormat pb.ContentFormat
+	openGraph     bool
+	limits        feedLimits
+}
+
+// cacheEntry is a single cached feed.
+type cacheEntry struct {
+	url      string
+	variant  cacheVariant
+	feed     *pb.Feed
+	size     int64
+	storedAt time.Time
+}
+
+// NewFeedCache constructs an empty FeedCache with the given TTL and byte limit, using defaults for zero values.
+func NewFeedCache(ttl time.Duration, maxBytes int64) *FeedCache {
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+	if maxBytes <= 0 {
+		maxBytes = defaultCacheMaxBytes
+	}
+	return &FeedCache{
+		ttl:      ttl,
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+		now:      time.Now,
+	}
+}
+
+// Configure applies the cache settings from config, evicting entries that no longer fit.
+func (c *FeedCache) Configure(config *pb.ClientConfig) error {
+	if err := validateCacheLimits(config); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ttl = durationOrDefault(config.GetCacheTtlMs(), defaultCacheTTL)
+	c.maxBytes = config.GetCacheMaxBytes()
+	if c.maxBytes == 0 {
+		c.maxBytes = defaultCacheMaxBytes
+	}
+	c.evictLocked()
+	return nil
+}
+
+// validateCacheLimits rejects negative cache limits.
+func validateCacheLimits(config *pb.ClientConfig) error {
+	if config.GetCacheTtlMs() < 0 || config.GetCacheMaxBytes() < 0 {
+		return fmt.Errorf("cache limits must not be negative")
+	}
+	return nil
+}
+
+// get returns a copy of the fresh feed cached for url under variant, marked as cached, or nil.
+func (c *FeedCache) get(url string, variant cacheVariant) *pb.Feed {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[url]
+	if !ok {
+		return nil
+	}
+	entry := element.Value.(*cacheEntry)
+
+	age := c.now().Sub(entry.storedAt)
+	if age >= c.ttl {
+		c.removeLocked(element)
+		return nil
+	}
+	if entry.variant != variant {
+		return nil
+	}
+	c.order.MoveToFront(element)
+
+	feed := goproto.Clone(entry.feed).(*pb.Feed)
+	feed.Cached = true
+	feed.CacheAgeMs = age.Milliseconds()
+	return feed
+}
+
+// put stores a copy of feed for url under variant, replacing any previous entry. Feeds without content (304
+// responses) and feeds larger than the whole cache are not stored.
+func (c *FeedCache) put(url string, variant cacheVariant, feed *pb.Feed) {
+	if c == nil || feed == nil || feed.GetNotModified() {
+		return
+	}
+
+	entry := &cacheEntry{
+		url:     url,
+		variant: variant,
+		feed:    goproto.Clone(feed).(*pb.Feed),
+		size:    int64(goproto.Size(feed)),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[url]; ok {
+		c.removeLocked(element)
+	}
+	if entry.size > c.maxBytes {
+		return
+	}
+
+	entry.storedAt = c.now()
+	c.entries[url] = c.order.PushFront(entry)
+	c.size += entry.size
+	c.evictLocked()
+}
+
+// ManageCache inspects or clears the cache. Clearing with no URLs empties it; inspection lists every fresh entry,
+// most recently used first.
+func (c *FeedCache) ManageCache(request *pb.CacheRequest) *pb.CacheResponse {
+	response := &pb.CacheResponse{Entries: make([]*pb.CacheEntry, 0)}
+	if c == nil {
+		return response
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch request.GetAction() {
+	case pb.CacheAction_CACHE_ACTION_CLEAR:
+		cleared := 0
+		if len(request.GetUrls()) == 0 {
+			cleared = len(c.entries)
+			c.order.Init()
+			c.entries = make(map[string]*list.Element)
+			c.size = 0
+		}
+		for _, url := range request.GetUrls() {
+			for _, key := range cacheKeysFor(url) {
+				if element, ok := c.entries[key]; ok {
+					c.removeLocked(element)
+					cleared++
+				}
+			}
+		}
+		response.Cleared = int32(cleared)
+	case pb.CacheAction_CACHE_ACTION_INSPECT:
+		now := c.now()
+		for element := c.order.Front(); element != nil; {
+			next := element.Next()
+			entry := element.Value.(*cacheEntry)
+			if age := now.Sub(entry.storedAt); age < c.ttl {
+				response.Entries = append(response.Entries, &pb.CacheEntry{
+					Url:         entry.url,
+					SizeBytes:   entry.size,
+					AgeMs:       age.Milliseconds(),
+					ExpiresInMs: (c.ttl - age).Milliseconds(),
+				})
+			} else {
+				c.removeLocked(element)
+			}
+			element = next
+		}
+	default:
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, fmt.Sprintf("unknown cache action %v", request.GetAction()), "")
+	}
+
+	response.TotalBytes = c.size
+	response.MaxBytes = c.maxBytes
+	response.TtlMs = c.ttl.Milliseconds()
+	return response
+}
+
+// cacheKeysFor lists the keys a feed requested as url may be cached under: its canonical form with and without
+// tracking parameters, or the trimmed URL when it cannot be normalized.
+func cacheKeysFor(url string) []string {
+	canonical, err := normalizeFeedURL(url, false)
+	if err != nil {
+		return []string{strings.TrimSpace(url)}
+	}
+	if stripped, _ := normalizeFeedURL(url, true); stripped != canonical {
+		return []string{canonical, stripped}
+	}
+	return []string{canonical}
+}
+
+// evictLocked drops least recently used entries until the cache fits its byte limit. c.mu must be held.
+func (c *FeedCache) evictLocked() {
+	for c.size > c.maxBytes {
+		oldest := c.order.Back()
+		if oldest == nil {
+			return
+		}
+		c.removeLocked(oldest)
+	}
+}
+
+// removeLocked deletes element from the cache. c.mu must be held.
+func (c *FeedCache) removeLocked(element *list.Element) {
+	entry := c.order.Remove(element).(*cacheEntry)
+	delete(c.entries, entry.url)
+	c.size -= entry.size
+}
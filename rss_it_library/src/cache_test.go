@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+// newCountingFeedServer serves testRSSFeed and counts the requests it receives.
+func newCountingFeedServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	t.Cleanup(server.Close)
+	return server, &hits
+}
+
+func TestFeedCache_GetPut(t *testing.T) {
+	cache := NewFeedCache(time.Minute, 0)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	variant := cacheVariant{}
+	cache.put("https://example.com/feed", variant, &pb.Feed{Url: "https://example.com/feed", Title: "Cached"})
+
+	now = now.Add(10 * time.Second)
+	feed := cache.get("https://example.com/feed", variant)
+	if feed == nil || feed.Title != "Cached" || !feed.Cached || feed.CacheAgeMs != 10000 {
+		t.Fatalf("expected cached feed aged 10s, got %+v", feed)
+	}
+	feed.Title = "Mutated"
+	if again := cache.get("https://example.com/feed", variant); again.Title != "Cached" {
+		t.Error("expected cache to hand out copies")
+	}
+
+	if cache.get("https://example.com/feed", cacheVariant{contentFormat: pb.ContentFormat_CONTENT_FORMAT_HTML}) != nil {
+		t.Error("expected a different conversion variant to miss")
+	}
+
+	now = now.Add(time.Minute)
+	if cache.get("https://example.com/feed", variant) != nil {
+		t.Error("expected expired entry to miss")
+	}
+	if len(cache.entries) != 0 || cache.size != 0 {
+		t.Errorf("expected expired entry to be removed, got %d entries (%d bytes)", len(cache.entries), cache.size)
+	}
+
+	cache.put("https://example.com/feed", variant, &pb.Feed{Url: "https://example.com/feed", NotModified: true})
+	if len(cache.entries) != 0 {
+		t.Error("expected 304 results not to be cached")
+	}
+
+	var nilCache *FeedCache
+	nilCache.put("https://example.com/feed", variant, &pb.Feed{})
+	if nilCache.get("https://example.com/feed", variant) != nil {
+		t.Error("expected nil cache to never hit")
+	}
+}
+
+func TestFeedCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	feed := func(url string) *pb.Feed {
+		return &pb.Feed{Url: url, Title: "A title long enough to give every entry the same size"}
+	}
+	cache := NewFeedCache(time.Minute, 0)
+
+	// Size the cache to hold exactly two entries.
+	cache.put("https://example.com/a", cacheVariant{}, feed("https://example.com/a"))
+	cache.maxBytes = cache.size * 2
+
+	cache.put("https://example.com/b", cacheVariant{}, feed("https://example.com/b"))
+	cache.get("https://example.com/a", cacheVariant{})
+	cache.put("https://example.com/c", cacheVariant{}, feed("https://example.com/c"))
+
+	if cache.get("https://example.com/b", cacheVariant{}) != nil {
+		t.Error("expected least recently used entry to be evicted")
+	}
+	if cache.get("https://example.com/a", cacheVariant{}) == nil || cache.get("https://example.com/c", cacheVariant{}) == nil {
+		t.Error("expected recently used entries to remain")
+	}
+}
+
+func TestFeedCache_ManageCache(t *testing.T) {
+	cache := NewFeedCache(time.Minute, 0)
+	cache.put("https://example.com/a", cacheVariant{}, &pb.Feed{Url: "https://example.com/a"})
+	cache.put("https://example.com/b", cacheVariant{}, &pb.Feed{Url: "https://example.com/b"})
+
+	inspected := cache.ManageCache(&pb.CacheRequest{})
+	if len(inspected.Entries) != 2 || inspected.Entries[0].Url != "https://example.com/b" {
+		t.Fatalf("expected both entries, most recent first, got %+v", inspected.Entries)
+	}
+	if inspected.TotalBytes != cache.size || inspected.TtlMs != time.Minute.Milliseconds() || inspected.Entries[0].ExpiresInMs <= 0 {
+		t.Errorf("unexpected cache statistics: %+v", inspected)
+	}
+
+	cleared := cache.ManageCache(&pb.CacheRequest{Action: pb.CacheAction_CACHE_ACTION_CLEAR, Urls: []string{"https://example.com/a", "https://example.com/missing"}})
+	if cleared.Cleared != 1 || len(cache.entries) != 1 {
+		t.Errorf("expected one entry cleared, got %d (%d remaining)", cleared.Cleared, len(cache.entries))
+	}
+
+	cleared = cache.ManageCache(&pb.CacheRequest{Action: pb.CacheAction_CACHE_ACTION_CLEAR})
+	if cleared.Cleared != 1 || cleared.TotalBytes != 0 || len(cache.entries) != 0 {
+		t.Errorf("expected cache emptied, got %+v", cleared)
+	}
+}
+
+func TestFeedCache_Configure(t *testing.T) {
+	cache := NewFeedCache(0, 0)
+	cache.put("https://example.com/a", cacheVariant{}, &pb.Feed{Url: "https://example.com/a", Title: "Entry"})
+
+	if err := cache.Configure(&pb.ClientConfig{CacheTtlMs: 1000, CacheMaxBytes: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cache.ttl != time.Second || cache.maxBytes != 1 || len(cache.entries) != 0 {
+		t.Errorf("expected new limits applied and oversized entry evicted, got ttl %v, max %d, %d entries", cache.ttl, cache.maxBytes, len(cache.entries))
+	}
+
+	if err := cache.Configure(&pb.ClientConfig{CacheTtlMs: -1}); err == nil {
+		t.Error("expected error for negative TTL")
+	}
+}
+
+func TestRSSParser_ParseFeeds_Cache(t *testing.T) {
+	server, hits := newCountingFeedServer(t)
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, NewFeedCache(time.Minute, 0))
+	request := &pb.ParseFeedsRequest{Urls: []string{server.URL}}
+
+	first := parser.ParseFeeds(context.Background(), request)
+	if first.Status != pb.ParseFeedsStatus_SUCCESS || first.Feeds[0].Cached {
+		t.Fatalf("expected fresh fetch, got %v (cached %v)", first.Status, first.Feeds[0].GetCached())
+	}
+
+	second := parser.ParseFeeds(context.Background(), request)
+	if second.Status != pb.ParseFeedsStatus_SUCCESS || !second.Feeds[0].Cached || second.Feeds[0].Title != first.Feeds[0].Title {
+		t.Fatalf("expected cached feed, got %+v", second.Feeds[0])
+	}
+	if hits.Load() != 1 {
+		t.Errorf("expected the cache to avoid a second request, got %d requests", hits.Load())
+	}
+
+	bypassed := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}, BypassCache: true})
+	if bypassed.Feeds[0].Cached || hits.Load() != 2 {
+		t.Errorf("expected bypass to fetch, got cached %v after %d requests", bypassed.Feeds[0].Cached, hits.Load())
+	}
+
+	refreshed := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}, RefreshCache: true})
+	if refreshed.Feeds[0].Cached || hits.Load() != 3 {
+		t.Errorf("expected refresh to fetch, got cached %v after %d requests", refreshed.Feeds[0].Cached, hits.Load())
+	}
+
+	html := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}, ContentFormat: pb.ContentFormat_CONTENT_FORMAT_HTML})
+	if html.Feeds[0].Cached || hits.Load() != 4 {
+		t.Errorf("expected a different content format to refetch, got cached %v after %d requests", html.Feeds[0].Cached, hits.Load())
+	}
+}
+
+func TestRSSParser_ParseFeeds_CacheVariesByLimits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testLargeFeed(5)))
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, NewFeedCache(time.Minute, 0))
+
+	limited := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}, Limits: &pb.FeedLimits{MaxItems: 2}})
+	if feed := limited.Feeds[0]; len(feed.Items) != 2 || !feed.Truncated {
+		t.Fatalf("expected a truncated 2-item feed, got %d items (truncated %v)", len(feed.Items), feed.Truncated)
+	}
+
+	normal := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}})
+	if feed := normal.Feeds[0]; feed.Cached || len(feed.Items) != 5 || feed.Truncated {
+		t.Errorf("expected the default limits to refetch the full feed, got %d items (cached %v, truncated %v)", len(feed.Items), feed.Cached, feed.Truncated)
+	}
+
+	again := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}})
+	if feed := again.Feeds[0]; !feed.Cached || len(feed.Items) != 5 {
+		t.Errorf("expected the same limits to be served from the cache, got %d items (cached %v)", len(feed.Items), feed.Cached)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// RequestRegistry tracks in-flight operations by caller-supplied request ID so they can be cancelled from Dart.
+type RequestRegistry struct {
+	mu       sync.Mutex
+	nextKey  uint64
+	requests map[string]map[uint64]context.CancelFunc
+}
+
+// NewRequestRegistry constructs an empty RequestRegistry.
+func NewRequestRegistry() *RequestRegistry {
+	return &RequestRegistry{
+		requests: make(map[string]map[uint64]context.CancelFunc),
+	}
+}
+
+// Track derives a cancellable context from ctx and registers it under requestID. The returned release function
+// must be called once the operation finishes. An empty requestID yields an untracked context.
+func (r *RequestRegistry) Track(ctx context.Context, requestID string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	requestID = strings.TrimSpace(requestID)
+	if requestID == "" {
+		return ctx, cancel
+	}
+
+	r.mu.Lock()
+	r.nextKey++
+	key := r.nextKey
+	if r.requests[requestID] == nil {
+		r.requests[requestID] = make(map[uint64]context.CancelFunc)
+	}
+	r.requests[requestID][key] = cancel
+	r.mu.Unlock()
+
+	return ctx, func() {
+		r.mu.Lock()
+		delete(r.requests[requestID], key)
+		if len(r.requests[requestID]) == 0 {
+			delete(r.requests, requestID)
+		}
+		r.mu.Unlock()
+		cancel()
+	}
+}
+
+// Cancel aborts every operation registered under requestID and reports whether any were found.
+func (r *RequestRegistry) Cancel(requestID string) bool {
+	requestID = strings.TrimSpace(requestID)
+
+	r.mu.Lock()
+	cancels := r.requests[requestID]
+	delete(r.requests, requestID)
+	r.mu.Unlock()
+
+	for _, cancel := range cancels {
+		cancel()
+	}
+	return len(cancels) > 0
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+func TestRequestRegistry_Cancel(t *testing.T) {
+	registry := NewRequestRegistry()
+
+	first, releaseFirst := registry.Track(context.Background(), "req-1")
+	defer releaseFirst()
+	second, releaseSecond := registry.Track(context.Background(), "req-1")
+	defer releaseSecond()
+	other, releaseOther := registry.Track(context.Background(), "req-2")
+	defer releaseOther()
+
+	if !registry.Cancel(" req-1 ") {
+		t.Fatal("expected tracked request to be cancelled")
+	}
+	if first.Err() == nil || second.Err() == nil {
+		t.Error("expected every context registered under the ID to be cancelled")
+	}
+	if other.Err() != nil {
+		t.Error("expected unrelated request to keep running")
+	}
+	if registry.Cancel("req-1") {
+		t.Error("expected a second cancel to find nothing")
+	}
+}
+
+func TestRequestRegistry_ReleaseUnregisters(t *testing.T) {
+	registry := NewRequestRegistry()
+
+	ctx, release := registry.Track(context.Background(), "req-1")
+	release()
+
+	if ctx.Err() == nil {
+		t.Error("expected release to cancel the derived context")
+	}
+	if registry.Cancel("req-1") {
+		t.Error("expected released request to be unregistered")
+	}
+}
+
+func TestRequestRegistry_EmptyIDIsUntracked(t *testing.T) {
+	registry := NewRequestRegistry()
+
+	ctx, release := registry.Track(context.Background(), "")
+	defer release()
+
+	if registry.Cancel("") {
+		t.Error("expected empty request ID not to be tracked")
+	}
+	if ctx.Err() != nil {
+		t.Error("expected untracked context to stay active")
+	}
+}
+
+func TestRequestRegistry_CancelAbortsParse(t *testing.T) {
+	blocked := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(blocked)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	registry := NewRequestRegistry()
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	request := &pb.ParseFeedsRequest{Urls: []string{server.URL}, RequestId: "screen-1"}
+
+	ctx, release := registry.Track(context.Background(), request.GetRequestId())
+	defer release()
+
+	go func() {
+		<-blocked
+		registry.Cancel("screen-1")
+	}()
+
+	done := make(chan *pb.ParseFeedsResponse, 1)
+	go func() { done <- parser.ParseFeeds(ctx, request) }()
+
+	select {
+	case response := <-done:
+		if len(response.Errors) != 1 || response.Errors[0].Kind != pb.ErrorKind_ERROR_KIND_CANCELLED {
+			t.Fatalf("expected a single cancelled error, got %v", response.Errors)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("parse was not aborted by cancel")
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	neturl "net/url"
+	"strings"
+	"unicode"
+
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+// maxSummaryRunes bounds summaries derived from full article content.
+const maxSummaryRunes = 280
+
+// conversionOptions carries the settings that shape how a parsed feed is converted to protobuf: the request's
+// output preferences plus what is known about the fetched document.
+type conversionOptions struct {
+	contentFormat pb.ContentFormat
+	documentURL   *neturl.URL
+	xmlBases      *xmlBases
+	feedType      string
+	limits        feedLimits
+}
+
+// newConversionOptions extracts the conversion settings from a parse request.
+func newConversionOptions(request *pb.ParseFeedsRequest) conversionOptions {
+	return conversionOptions{
+		contentFormat: request.GetContentFormat(),
+		limits:        newFeedLimits(request.GetLimits()),
+	}
+}
+
+// forFetch returns a copy of the options describing the document behind result.
+func (o conversionOptions) forFetch(result *fetchResult) conversionOptions {
+	if documentURL, err := neturl.Parse(result.diagnostics.GetFinalUrl()); err == nil && documentURL.IsAbs() {
+		o.documentURL = documentURL
+	}
+	o.xmlBases = result.xmlBases
+	return o
+}
+
+// itemBody returns an item's description and full content rendered in the requested format, resolving relative
+// URLs against base. Content prefers content:encoded / Atom content over the description; a missing description
+// is derived from the content.
+func itemBody(item *gofeed.Item, base *neturl.URL, options conversionOptions) (description, content string) {
+	raw := item.Content
+	if strings.TrimSpace(raw) == "" {
+		raw = item.Description
+	}
+	content = sanitizeHTML(raw, base)
+
+	switch options.contentFormat {
+	case pb.ContentFormat_CONTENT_FORMAT_HTML:
+		description = sanitizeHTML(item.Description, base)
+		if description == "" {
+			description = html.EscapeString(itemSummary("", content, base))
+		}
+	case pb.ContentFormat_CONTENT_FORMAT_PLAIN_TEXT:
+		description = itemSummary(item.Description, content, base)
+		content = cleanWhitespace(htmlText(content))
+	default:
+		description = itemSummary(item.Description, content, base)
+	}
+	return description, content
+}
+
+// itemSummary returns the plain-text description, deriving one from content when the feed provides none.
+func itemSummary(description, content string, base *neturl.URL) string {
+	if summary := plainText(description, base); summary != "" {
+		return summary
+	}
+	return truncateText(cleanWhitespace(htmlText(content)), maxSummaryRunes)
+}
+
+// plainText sanitizes an HTML fragment and flattens it to text, so script and style bodies never leak through.
+func plainText(fragment string, base *neturl.URL) string {
+	return cleanWhitespace(htmlText(sanitizeHTML(fragment, base)))
+}
+
+// blockElements separate runs of text when an HTML fragment is flattened to plain text.
+var blockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "br": true, "dd": true, "div": true,
+	"dl": true, "dt": true, "figcaption": true, "figure": true, "footer": true, "h1": true, "h2": true, "h3": true,
+	"h4": true, "h5": true, "h6": true, "header": true, "hr": true, "li": true, "ol": true, "p": true, "pre": true,
+	"section": true, "table": true, "td": true, "th": true, "tr": true, "ul": true,
+}
+
+// htmlText flattens an HTML fragment to its text, keeping block elements apart.
+func htmlText(fragment string) string {
+	nodes, err := html.ParseFragment(strings.NewReader(fragment), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return cleanString(fragment)
+	}
+
+	var builder strings.Builder
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		switch {
+		case node.Type == html.TextNode:
+			builder.WriteString(node.Data)
+		case node.Type == html.ElementNode && blockElements[node.Data]:
+			builder.WriteByte(' ')
+			defer builder.WriteByte(' ')
+		}
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	for _, node := range nodes {
+		walk(node)
+	}
+	return builder.String()
+}
+
+// truncateText shortens text to at most limit runes, cutting at a word boundary and appending an ellipsis.
+func truncateText(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+
+	cut := limit
+	for i := limit; i > limit/2; i-- {
+		if unicode.IsSpace(runes[i]) {
+			cut = i
+			break
+		}
+	}
+	return strings.TrimRightFunc(string(runes[:cut]), func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + "…"
+}
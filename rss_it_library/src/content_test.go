@@ -0,0 +1,125 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+const testContentFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:content="http://purl.org/rss/1.0/modules/content/">
+  <channel>
+    <title>Content Feed</title>
+    <link>https://example.com/</link>
+    <item>
+      <title>Full article</title>
+      <description>Short &lt;b&gt;teaser&lt;/b&gt;.</description>
+      <content:encoded><![CDATA[<p>The <em>whole</em> story.</p><script>alert(1)</script>]]></content:encoded>
+    </item>
+    <item>
+      <title>Content only</title>
+      <content:encoded><![CDATA[<h1>Heading</h1><p>Body text follows here.</p>]]></content:encoded>
+    </item>
+    <item>
+      <title>Description only</title>
+      <description>&lt;p&gt;Just a &lt;a href="https://example.com/"&gt;description&lt;/a&gt;.&lt;/p&gt;</description>
+    </item>
+  </channel>
+</rss>`
+
+func TestToProtoFeedItem_Content(t *testing.T) {
+	feed, err := gofeed.NewParser().ParseString(testContentFeed)
+	if err != nil {
+		t.Fatalf("failed to parse fixture: %v", err)
+	}
+
+	full := toProtoFeedItem(feed.Items[0], urlResolver{}, conversionOptions{})
+	if full.GetContent() != "<p>The <em>whole</em> story.</p>" {
+		t.Errorf("expected sanitised content:encoded, got %q", full.GetContent())
+	}
+	if full.GetDescription() != "Short teaser." {
+		t.Errorf("expected plain-text description, got %q", full.GetDescription())
+	}
+
+	contentOnly := toProtoFeedItem(feed.Items[1], urlResolver{}, conversionOptions{})
+	if contentOnly.GetDescription() != "Heading Body text follows here." {
+		t.Errorf("expected summary derived from content, got %q", contentOnly.GetDescription())
+	}
+
+	descriptionOnly := toProtoFeedItem(feed.Items[2], urlResolver{}, conversionOptions{})
+	if descriptionOnly.GetContent() != `<p>Just a <a href="https://example.com/">description</a>.</p>` {
+		t.Errorf("expected description markup as content, got %q", descriptionOnly.GetContent())
+	}
+	if descriptionOnly.GetDescription() != "Just a description." {
+		t.Errorf("expected plain-text description, got %q", descriptionOnly.GetDescription())
+	}
+
+	if empty := toProtoFeedItem(&gofeed.Item{Title: "Nothing"}, urlResolver{}, conversionOptions{}); empty.Content != nil || empty.Description != nil {
+		t.Errorf("expected no content or description, got %q / %q", empty.GetContent(), empty.GetDescription())
+	}
+}
+
+func TestToProtoFeedItem_ContentFormat(t *testing.T) {
+	item := &gofeed.Item{
+		Link:        "https://example.com/posts/1",
+		Description: `<p>Teaser with <a href="/more">link</a></p>`,
+		Content:     `<p>Full <img src="img/a.png"> body</p><script>x()</script>`,
+	}
+
+	plain := toProtoFeedItem(item, urlResolver{}, conversionOptions{contentFormat: pb.ContentFormat_CONTENT_FORMAT_PLAIN_TEXT})
+	if plain.GetDescription() != "Teaser with link" || plain.GetContent() != "Full body" {
+		t.Errorf("unexpected plain text output: %q / %q", plain.GetDescription(), plain.GetContent())
+	}
+
+	rich := toProtoFeedItem(item, urlResolver{}, conversionOptions{contentFormat: pb.ContentFormat_CONTENT_FORMAT_HTML})
+	if rich.GetDescription() != `<p>Teaser with <a href="https://example.com/more">link</a></p>` {
+		t.Errorf("unexpected HTML description %q", rich.GetDescription())
+	}
+	if rich.GetContent() != `<p>Full <img src="https://example.com/posts/img/a.png"> body</p>` {
+		t.Errorf("unexpected HTML content %q", rich.GetContent())
+	}
+
+	derived := toProtoFeedItem(&gofeed.Item{Content: "<p>Fish &amp; chips</p>"}, urlResolver{}, conversionOptions{contentFormat: pb.ContentFormat_CONTENT_FORMAT_HTML})
+	if derived.GetDescription() != "Fish &amp; chips" {
+		t.Errorf("expected escaped derived summary, got %q", derived.GetDescription())
+	}
+}
+
+func TestToProtoFeedItem_DescriptionDropsScripts(t *testing.T) {
+	item := &gofeed.Item{Description: `<style>p { color: red; }</style><script>alert('x')</script>Hello <b>world</b>`}
+
+	for _, format := range []pb.ContentFormat{pb.ContentFormat_CONTENT_FORMAT_DEFAULT, pb.ContentFormat_CONTENT_FORMAT_PLAIN_TEXT} {
+		converted := toProtoFeedItem(item, urlResolver{}, conversionOptions{contentFormat: format})
+		if converted.GetDescription() != "Hello world" {
+			t.Errorf("%v: expected script and style bodies to be dropped, got %q", format, converted.GetDescription())
+		}
+	}
+}
+
+func TestHTMLText(t *testing.T) {
+	got := cleanWhitespace(htmlText(`<h2>Title</h2><p>First &amp; <em>second</em>.</p><ul><li>one</li><li>two</li></ul>`))
+	if got != "Title First & second. one two" {
+		t.Errorf("unexpected text %q", got)
+	}
+}
+
+func TestTruncateText(t *testing.T) {
+	if got := truncateText("short text", 20); got != "short text" {
+		t.Errorf("expected untouched text, got %q", got)
+	}
+
+	long := strings.Repeat("word ", 100)
+	got := truncateText(long, 42)
+	if !strings.HasSuffix(got, "…") {
+		t.Errorf("expected ellipsis, got %q", got)
+	}
+	if utf8.RuneCountInString(got) > 43 {
+		t.Errorf("expected at most 42 runes plus ellipsis, got %d", utf8.RuneCountInString(got))
+	}
+	if strings.Contains(got, "wor…") {
+		t.Errorf("expected cut at a word boundary, got %q", got)
+	}
+}
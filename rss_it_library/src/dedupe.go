@@ -0,0 +1,175 @@
+package main
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+
+	goproto "google.golang.org/protobuf/proto"
+
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+// feedFlight is a single fetch shared by every requested URL with the same canonical form.
+type feedFlight struct {
+	canonicalURL string
+	// inputs are the trimmed URLs as requested, in request order; the same URL may appear more than once.
+	inputs []string
+	// indices holds the position of each input in the request's urls.
+	indices []int32
+}
+
+// groupFeedURLs coalesces the requested URLs by canonical form, keeping the order in which each canonical URL was
+// first requested. URLs that are empty or cannot be normalized are returned as validation errors instead.
+func groupFeedURLs(urls []string, stripTracking bool) ([]*feedFlight, []*pb.ErrorDetail) {
+	flights := make([]*feedFlight, 0, len(urls))
+	byCanonical := make(map[string]*feedFlight, len(urls))
+	invalid := make([]*pb.ErrorDetail, 0)
+
+	for index, candidate := range urls {
+		rawURL := strings.TrimSpace(candidate)
+		if rawURL == "" {
+			detail := newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, "feed URL is empty", candidate)
+			detail.InputIndex = goproto.Int32(int32(index))
+			invalid = append(invalid, detail)
+			continue
+		}
+
+		canonicalURL, err := normalizeFeedURL(rawURL, stripTracking)
+		if err != nil {
+			detail := newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, err.Error(), candidate)
+			detail.InputIndex = goproto.Int32(int32(index))
+			invalid = append(invalid, detail)
+			continue
+		}
+
+		flight, ok := byCanonical[canonicalURL]
+		if !ok {
+			flight = &feedFlight{canonicalURL: canonicalURL}
+			byCanonical[canonicalURL] = flight
+			flights = append(flights, flight)
+		}
+		flight.inputs = append(flight.inputs, rawURL)
+		flight.indices = append(flight.indices, int32(index))
+	}
+	return flights, invalid
+}
+
+// feeds fans feed out to every input of the flight. Each copy carries its own input as url along with its index, and
+// lists the other inputs it was merged with, followed by earlier, the inputs of feeds already reported for the same
+// final location.
+func (f *feedFlight) feeds(feed *pb.Feed, earlier []string) []*pb.Feed {
+	feeds := make([]*pb.Feed, 0, len(f.inputs))
+	for i, input := range f.inputs {
+		copied := feed
+		if i < len(f.inputs)-1 {
+			copied = goproto.Clone(feed).(*pb.Feed)
+		}
+		copied.Url = input
+		copied.InputIndex = f.indices[i]
+		copied.CanonicalUrl = f.canonicalURL
+		copied.MergedUrls = f.mergedWith(i, earlier)
+		feeds = append(feeds, copied)
+	}
+	return feeds
+}
+
+// errors fans a fetch error out to every input of the flight, like feeds.
+func (f *feedFlight) errors(detail *pb.ErrorDetail) []*pb.ErrorDetail {
+	details := make([]*pb.ErrorDetail, 0, len(f.inputs))
+	for i, input := range f.inputs {
+		copied := detail
+		if i < len(f.inputs)-1 {
+			copied = goproto.Clone(detail).(*pb.ErrorDetail)
+		}
+		copied.Url = input
+		copied.InputIndex = goproto.Int32(f.indices[i])
+		copied.CanonicalUrl = f.canonicalURL
+		copied.MergedUrls = f.mergedWith(i, nil)
+		details = append(details, copied)
+	}
+	return details
+}
+
+// mergedWith lists every input except the one at index, followed by earlier.
+func (f *feedFlight) mergedWith(index int, earlier []string) []string {
+	merged := make([]string, 0, len(f.inputs)-1+len(earlier))
+	for i, input := range f.inputs {
+		if i != index {
+			merged = append(merged, input)
+		}
+	}
+	return append(merged, earlier...)
+}
+
+// finalLocations remembers where the feeds of a request ended up after redirects. Distinct URLs that redirect to
+// the same feed are only recognisable once fetched, so they are fetched separately and reported as merged.
+type finalLocations struct {
+	mu            sync.Mutex
+	stripTracking bool
+	inputs        map[string][]string
+}
+
+// newFinalLocations returns an empty set of final locations, normalized like the request's URLs.
+func newFinalLocations(stripTracking bool) *finalLocations {
+	return &finalLocations{stripTracking: stripTracking, inputs: make(map[string][]string)}
+}
+
+// claim records that feed was served to inputs and returns the inputs of feeds already reported for the same final
+// location. Feeds without a known final location are not tracked.
+func (l *finalLocations) claim(feed *pb.Feed, inputs []string) []string {
+	finalURL := finalLocation(feed, l.stripTracking)
+	if finalURL == "" {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	earlier := l.inputs[finalURL]
+	l.inputs[finalURL] = append(earlier[:len(earlier):len(earlier)], inputs...)
+	return earlier
+}
+
+// finalLocation returns the normalized URL feed was served from after redirects, or "" when it is unknown.
+func finalLocation(feed *pb.Feed, stripTracking bool) string {
+	finalURL := feed.GetDiagnostics().GetFinalUrl()
+	if finalURL == "" {
+		return ""
+	}
+	if canonical, err := normalizeFeedURL(finalURL, stripTracking); err == nil {
+		finalURL = canonical
+	}
+	return finalURL
+}
+
+// mergeFinalLocations rewrites merged_urls once every feed of a request is known, so that each feed served from the
+// same final location lists the inputs of all the others, not only of those reported before it. Streamed results
+// cannot be revised and keep the one-way report made by claim.
+func mergeFinalLocations(feeds []*pb.Feed, stripTracking bool) {
+	byLocation := make(map[string][]*pb.Feed)
+	for _, feed := range feeds {
+		if location := finalLocation(feed, stripTracking); location != "" {
+			byLocation[location] = append(byLocation[location], feed)
+		}
+	}
+
+	for _, group := range byLocation {
+		if len(group) < 2 {
+			continue
+		}
+		slices.SortStableFunc(group, func(a, b *pb.Feed) int {
+			return cmp.Compare(a.GetInputIndex(), b.GetInputIndex())
+		})
+		for _, feed := range group {
+			merged := make([]string, 0, len(group)-1)
+			for _, other := range group {
+				if other != feed {
+					merged = append(merged, other.Url)
+				}
+			}
+			feed.MergedUrls = merged
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+func TestGroupFeedURLs(t *testing.T) {
+	flights, invalid := groupFeedURLs([]string{
+		"https://example.com/feed",
+		" example.com/feed#top ",
+		"https://example.com/other",
+		"",
+		"https://EXAMPLE.com:443/feed",
+	}, false)
+
+	if len(invalid) != 1 || invalid[0].Kind != pb.ErrorKind_ERROR_KIND_VALIDATION {
+		t.Fatalf("expected one validation error, got %v", invalid)
+	}
+	if len(flights) != 2 {
+		t.Fatalf("expected two flights, got %d", len(flights))
+	}
+	if want := []string{"https://example.com/feed", "example.com/feed#top", "https://EXAMPLE.com:443/feed"}; !slices.Equal(flights[0].inputs, want) {
+		t.Errorf("expected inputs %v, got %v", want, flights[0].inputs)
+	}
+	if flights[1].canonicalURL != "https://example.com/other" || len(flights[1].inputs) != 1 {
+		t.Errorf("unexpected second flight %+v", flights[1])
+	}
+}
+
+func TestFeedFlight_Feeds(t *testing.T) {
+	flight := &feedFlight{canonicalURL: "https://example.com/feed", inputs: []string{"a", "b"}, indices: []int32{0, 3}}
+	feeds := flight.feeds(&pb.Feed{Title: "Shared"}, []string{"c"})
+
+	if len(feeds) != 2 || feeds[0] == feeds[1] {
+		t.Fatalf("expected two distinct copies, got %v", feeds)
+	}
+	if feeds[0].Url != "a" || feeds[0].InputIndex != 0 || !slices.Equal(feeds[0].MergedUrls, []string{"b", "c"}) {
+		t.Errorf("unexpected first copy %v", feeds[0])
+	}
+	if feeds[1].Url != "b" || feeds[1].InputIndex != 3 || !slices.Equal(feeds[1].MergedUrls, []string{"a", "c"}) || feeds[1].Title != "Shared" {
+		t.Errorf("unexpected second copy %v", feeds[1])
+	}
+}
+
+func TestRSSParser_ParseFeeds_CoalescesDuplicates(t *testing.T) {
+	server, hits := newCountingFeedServer(t)
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	urls := []string{server.URL + "/feed", server.URL + "/feed#latest", server.URL + "/feed"}
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: urls})
+
+	if got := hits.Load(); got != 1 {
+		t.Errorf("expected a single fetch, got %d", got)
+	}
+	if len(response.Feeds) != 3 || response.Status != pb.ParseFeedsStatus_SUCCESS {
+		t.Fatalf("expected a feed per requested URL, got %d (errors %v)", len(response.Feeds), response.Errors)
+	}
+
+	requested := make([]string, 0, len(response.Feeds))
+	for _, feed := range response.Feeds {
+		requested = append(requested, feed.Url)
+		if len(feed.MergedUrls) != 2 || feed.Title != "Test Feed" {
+			t.Errorf("expected %s to be merged with the other two inputs, got %v", feed.Url, feed.MergedUrls)
+		}
+	}
+	slices.Sort(requested)
+	sorted := slices.Sorted(slices.Values(urls))
+	if !slices.Equal(requested, sorted) {
+		t.Errorf("expected feeds for %v, got %v", sorted, requested)
+	}
+}
+
+func TestRSSParser_ParseFeeds_CoalescesErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL, server.URL + "/"}})
+
+	if len(response.Errors) != 2 {
+		t.Fatalf("expected an error per requested URL, got %v", response.Errors)
+	}
+	for _, detail := range response.Errors {
+		if detail.HttpStatus != http.StatusNotFound || len(detail.MergedUrls) != 1 || detail.CanonicalUrl != server.URL+"/" {
+			t.Errorf("unexpected error %v", detail)
+		}
+	}
+}
+
+func TestRSSParser_ParseFeeds_MergesRedirects(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/feed" {
+			http.Redirect(w, r, "/feed", http.StatusMovedPermanently)
+			return
+		}
+		hits.Add(1)
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, 1, nil)
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL + "/old", server.URL + "/rss"}})
+
+	if len(response.Feeds) != 2 {
+		t.Fatalf("expected two feeds, got %v", response.Errors)
+	}
+	if got := hits.Load(); got != 2 {
+		t.Errorf("expected each URL to be fetched, got %d fetches", got)
+	}
+	first, second := response.Feeds[0], response.Feeds[1]
+	if !slices.Equal(first.MergedUrls, []string{second.Url}) || !slices.Equal(second.MergedUrls, []string{first.Url}) {
+		t.Errorf("expected both feeds to name each other, got %v and %v", first.MergedUrls, second.MergedUrls)
+	}
+}
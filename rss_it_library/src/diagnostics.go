@@ -0,0 +1,180 @@
+package main
+
+import (
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+// fetchTrace accumulates timing and transfer details for a single feed fetch, including any redirects.
+type fetchTrace struct {
+	mu sync.Mutex
+
+	start        time.Time
+	dnsStart     time.Time
+	connectStart time.Time
+	tlsStart     time.Time
+	parseStart   time.Time
+
+	dns     time.Duration
+	connect time.Duration
+	tls     time.Duration
+	ttfb    time.Duration
+	parse   time.Duration
+
+	reused      bool
+	httpCache   pb.HttpCacheStatus
+	statusCode  int
+	finalURL    string
+	contentType string
+	bytesRead   int64
+}
+
+// newFetchTrace starts timing a fetch of requestURL.
+func newFetchTrace(requestURL string) *fetchTrace {
+	return &fetchTrace{
+		start:    time.Now(),
+		finalURL: requestURL,
+	}
+}
+
+// clientTrace returns httptrace hooks feeding this trace. Hooks may fire concurrently while dialing.
+func (t *fetchTrace) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.mu.Lock()
+			t.dnsStart = time.Now()
+			t.mu.Unlock()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.mu.Lock()
+			if !t.dnsStart.IsZero() {
+				t.dns += time.Since(t.dnsStart)
+			}
+			t.mu.Unlock()
+		},
+		ConnectStart: func(string, string) {
+			t.mu.Lock()
+			if t.connectStart.IsZero() {
+				t.connectStart = time.Now()
+			}
+			t.mu.Unlock()
+		},
+		ConnectDone: func(_, _ string, err error) {
+			t.mu.Lock()
+			if err == nil && !t.connectStart.IsZero() {
+				t.connect += time.Since(t.connectStart)
+				t.connectStart = time.Time{}
+			}
+			t.mu.Unlock()
+		},
+		TLSHandshakeStart: func() {
+			t.mu.Lock()
+			t.tlsStart = time.Now()
+			t.mu.Unlock()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.mu.Lock()
+			if !t.tlsStart.IsZero() {
+				t.tls += time.Since(t.tlsStart)
+			}
+			t.mu.Unlock()
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			t.reused = info.Reused
+			t.mu.Unlock()
+		},
+		GotFirstResponseByte: func() {
+			t.mu.Lock()
+			t.ttfb = time.Since(t.start)
+			t.mu.Unlock()
+		},
+	}
+}
+
+// recordResponse captures metadata from the final response and wraps its body to count downloaded bytes. Bodies
+// served from the HTTP cache are not counted.
+func (t *fetchTrace) recordResponse(resp *http.Response) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.statusCode = resp.StatusCode
+	t.contentType = resp.Header.Get("Content-Type")
+	t.httpCache = httpCacheStatus(resp.Header.Get(httpCacheStatusHeader))
+	if resp.Request != nil && resp.Request.URL != nil {
+		t.finalURL = resp.Request.URL.String()
+	}
+	if t.httpCache == pb.HttpCacheStatus_HTTP_CACHE_STATUS_HIT || t.httpCache == pb.HttpCacheStatus_HTTP_CACHE_STATUS_REVALIDATED {
+		// The body comes from the on-disk cache, not the network.
+		return
+	}
+	resp.Body = &countingReadCloser{ReadCloser: resp.Body, trace: t}
+}
+
+// startParse marks the beginning of feed parsing, once the body has been downloaded.
+func (t *fetchTrace) startParse() {
+	t.mu.Lock()
+	t.parseStart = time.Now()
+	t.mu.Unlock()
+}
+
+// finish stops the clock and returns the collected diagnostics.
+func (t *fetchTrace) finish() *pb.FetchDiagnostics {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.parseStart.IsZero() {
+		t.parse = time.Since(t.parseStart)
+	}
+
+	return &pb.FetchDiagnostics{
+		StatusCode:       int32(t.statusCode),
+		FinalUrl:         t.finalURL,
+		ContentType:      t.contentType,
+		BytesDownloaded:  t.bytesRead,
+		DnsMs:            t.dns.Milliseconds(),
+		ConnectMs:        t.connect.Milliseconds(),
+		TlsMs:            t.tls.Milliseconds(),
+		TtfbMs:           t.ttfb.Milliseconds(),
+		ParseMs:          t.parse.Milliseconds(),
+		TotalMs:          time.Since(t.start).Milliseconds(),
+		ConnectionReused: t.reused,
+		HttpCache:        t.httpCache,
+	}
+}
+
+// httpCacheStatus maps the status diskCache attaches to a response onto its protobuf form.
+func httpCacheStatus(value string) pb.HttpCacheStatus {
+	switch value {
+	case httpCacheMiss:
+		return pb.HttpCacheStatus_HTTP_CACHE_STATUS_MISS
+	case httpCacheHit:
+		return pb.HttpCacheStatus_HTTP_CACHE_STATUS_HIT
+	case httpCacheRevalidated:
+		return pb.HttpCacheStatus_HTTP_CACHE_STATUS_REVALIDATED
+	default:
+		return pb.HttpCacheStatus_HTTP_CACHE_STATUS_NONE
+	}
+}
+
+// countingReadCloser tallies bytes read from a response body into its trace.
+type countingReadCloser struct {
+	io.ReadCloser
+	trace *fetchTrace
+}
+
+func (c *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	if n > 0 {
+		c.trace.mu.Lock()
+		c.trace.bytesRead += int64(n)
+		c.trace.mu.Unlock()
+	}
+	return n, err
+}
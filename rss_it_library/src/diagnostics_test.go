@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+func TestFetchFeed_Diagnostics(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/feed.xml", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/feed.xml", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+		_, _ = w.Write([]byte(testRSSFeed))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	result, err := fetchFeed(context.Background(), gofeed.NewParser(), server.URL+"/old", nil, defaultMaxBodyBytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	diagnostics := result.diagnostics
+	if diagnostics == nil {
+		t.Fatal("expected diagnostics to be populated")
+	}
+	if diagnostics.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", diagnostics.StatusCode)
+	}
+	if diagnostics.FinalUrl != server.URL+"/feed.xml" {
+		t.Errorf("expected final URL after redirect, got %q", diagnostics.FinalUrl)
+	}
+	if diagnostics.ContentType != "application/rss+xml; charset=utf-8" {
+		t.Errorf("unexpected content type %q", diagnostics.ContentType)
+	}
+	if diagnostics.BytesDownloaded != int64(len(testRSSFeed)) {
+		t.Errorf("expected %d bytes downloaded, got %d", len(testRSSFeed), diagnostics.BytesDownloaded)
+	}
+	if diagnostics.TotalMs < diagnostics.TtfbMs || diagnostics.TotalMs < diagnostics.ParseMs {
+		t.Errorf("expected total duration to cover TTFB and parsing, got %v", diagnostics)
+	}
+}
+
+func TestFetchFeed_ParseTimeExcludesDownload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		half := len(testRSSFeed) / 2
+		_, _ = w.Write([]byte(testRSSFeed[:half]))
+		w.(http.Flusher).Flush()
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte(testRSSFeed[half:]))
+	}))
+	defer server.Close()
+
+	result, err := fetchFeed(context.Background(), gofeed.NewParser(), server.URL, nil, defaultMaxBodyBytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diagnostics := result.diagnostics; diagnostics.TotalMs < 200 || diagnostics.ParseMs >= 100 {
+		t.Errorf("expected the slow body to count towards the total but not parsing, got %v", diagnostics)
+	}
+}
+
+func TestRSSParser_ParseFeeds_ErrorDiagnostics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}})
+
+	if len(response.Errors) != 1 {
+		t.Fatalf("expected one error, got %v", response.Errors)
+	}
+	diagnostics := response.Errors[0].GetDiagnostics()
+	if diagnostics == nil {
+		t.Fatal("expected diagnostics on per-feed error")
+	}
+	if diagnostics.StatusCode != http.StatusServiceUnavailable || diagnostics.ContentType != "text/html" {
+		t.Errorf("unexpected diagnostics %v", diagnostics)
+	}
+}
+
+func TestRSSParser_ParseFeeds_FeedDiagnostics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}})
+
+	if len(response.Feeds) != 1 {
+		t.Fatalf("expected one feed, got %v", response.Errors)
+	}
+	diagnostics := response.Feeds[0].GetDiagnostics()
+	if diagnostics == nil || diagnostics.StatusCode != http.StatusOK || diagnostics.FinalUrl != server.URL+"/" {
+		t.Errorf("unexpected diagnostics %v", diagnostics)
+	}
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/sync/errgroup"
+
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+const (
+	defaultDiscoveryTimeout = 15 * time.Second
+	maxDiscoveryPageBytes   = 2 << 20
+	discoveryProbeLimit     = 4
+)
+
+// feedMIMETypes lists the link types advertised for RSS, Atom and JSON Feed documents. Plain application/json is
+// left out: WordPress advertises its REST API with it on every page.
+var feedMIMETypes = map[string]bool{
+	"application/rss+xml":   true,
+	"application/atom+xml":  true,
+	"application/feed+json": true,
+}
+
+// wellKnownFeedPaths are probed, in order, when a page advertises no feeds.
+var wellKnownFeedPaths = []string{
+	"/feed",
+	"/rss",
+	"/rss.xml",
+	"/atom.xml",
+	"/feed.xml",
+	"/index.xml",
+	"/feed.json",
+}
+
+// FeedDiscoverer locates feeds advertised by, or conventionally hosted alongside, an HTML page.
+type FeedDiscoverer struct {
+	newParser func() *gofeed.Parser
+	timeout   time.Duration
+}
+
+// NewFeedDiscoverer constructs a FeedDiscoverer using the supplied parser factory and timeout.
+func NewFeedDiscoverer(newParser func() *gofeed.Parser, timeout time.Duration) *FeedDiscoverer {
+	if newParser == nil {
+		newParser = gofeed.NewParser
+	}
+	if timeout <= 0 {
+		timeout = defaultDiscoveryTimeout
+	}
+	return &FeedDiscoverer{
+		newParser: newParser,
+		timeout:   timeout,
+	}
+}
+
+// DiscoverFeeds fetches the requested page and returns every feed candidate it can find.
+// A URL that already points at a feed is returned as the single candidate.
+func (d *FeedDiscoverer) DiscoverFeeds(ctx context.Context, request *pb.DiscoverFeedsRequest) *pb.DiscoverFeedsResponse {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	response := &pb.DiscoverFeedsResponse{Feeds: make([]*pb.DiscoveredFeed, 0)}
+
+	if request == nil {
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, "discover request is empty", "")
+		return response
+	}
+
+	pageURL := strings.TrimSpace(request.GetUrl())
+	if pageURL == "" {
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, "page URL is empty", "")
+		return response
+	}
+
+	// Homepages are usually pasted without a scheme, so normalize before fetching.
+	canonicalURL, err := normalizeFeedURL(pageURL, false)
+	if err != nil {
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, err.Error(), pageURL)
+		return response
+	}
+
+	discoverCtx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	parser := d.newParser()
+
+	body, finalURL, err := fetchPage(discoverCtx, parser, canonicalURL)
+	if err != nil {
+		response.Error = newFetchErrorDetail(err, pageURL)
+		response.Error.CanonicalUrl = canonicalURL
+		return response
+	}
+
+	if feed, err := parser.Parse(bytes.NewReader(body)); err == nil {
+		response.Feeds = append(response.Feeds, &pb.DiscoveredFeed{
+			Url:      finalURL.String(),
+			Title:    cleanString(feed.Title),
+			MimeType: feedMIMEType(feed.FeedType),
+			Source:   pb.DiscoverySource_DISCOVERY_SOURCE_DIRECT,
+		})
+		return response
+	}
+
+	candidates, err := linkedFeeds(body, finalURL)
+	if err != nil {
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_PARSING, err.Error(), pageURL)
+		return response
+	}
+	if len(candidates) == 0 {
+		candidates = d.probeWellKnownPaths(discoverCtx, finalURL)
+	}
+
+	if len(candidates) == 0 {
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, "no feeds discovered", pageURL)
+		return response
+	}
+
+	response.Feeds = candidates
+	return response
+}
+
+// probeWellKnownPaths fetches conventional feed locations on the page's host and keeps those that parse.
+func (d *FeedDiscoverer) probeWellKnownPaths(ctx context.Context, pageURL *neturl.URL) []*pb.DiscoveredFeed {
+	// Each probe writes its own slot, keeping results in wellKnownFeedPaths order without locking.
+	results := make([]*pb.DiscoveredFeed, len(wellKnownFeedPaths))
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(discoveryProbeLimit)
+
+	for i, path := range wellKnownFeedPaths {
+		candidateURL := pageURL.ResolveReference(&neturl.URL{Path: path}).String()
+		group.Go(func() error {
+			result, err := fetchFeed(groupCtx, d.newParser(), candidateURL, nil, defaultMaxBodyBytes)
+			if err != nil || result.feed == nil {
+				return nil
+			}
+
+			results[i] = &pb.DiscoveredFeed{
+				Url:      candidateURL,
+				Title:    cleanString(result.feed.Title),
+				MimeType: feedMIMEType(result.feed.FeedType),
+				Source:   pb.DiscoverySource_DISCOVERY_SOURCE_WELL_KNOWN_PATH,
+			}
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	feeds := make([]*pb.DiscoveredFeed, 0, len(results))
+	for _, feed := range results {
+		if feed != nil {
+			feeds = append(feeds, feed)
+		}
+	}
+	return feeds
+}
+
+// fetchPage downloads pageURL and returns its (size-capped) body along with the URL reached after redirects.
+func fetchPage(ctx context.Context, parser *gofeed.Parser, pageURL string) ([]byte, *neturl.URL, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if parser.UserAgent != "" {
+		req.Header.Set("User-Agent", parser.UserAgent)
+	}
+
+	client := parser.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, nil, newStatusError(resp)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxDiscoveryPageBytes))
+	if err != nil {
+		return nil, nil, err
+	}
+	return body, resp.Request.URL, nil
+}
+
+// linkedFeeds extracts feeds advertised through <link rel="alternate"> tags, resolving them against the page URL.
+func linkedFeeds(page []byte, pageURL *neturl.URL) ([]*pb.DiscoveredFeed, error) {
+	document, err := goquery.NewDocumentFromReader(bytes.NewReader(page))
+	if err != nil {
+		return nil, fmt.Errorf("parse HTML: %w", err)
+	}
+
+	base := pageURL
+	if href, ok := document.Find("base[href]").First().Attr("href"); ok {
+		if resolved, err := pageURL.Parse(strings.TrimSpace(href)); err == nil {
+			base = resolved
+		}
+	}
+
+	seen := make(map[string]bool)
+	feeds := make([]*pb.DiscoveredFeed, 0)
+
+	document.Find("link[rel][href]").Each(func(_ int, link *goquery.Selection) {
+		rel, _ := link.Attr("rel")
+		if !hasToken(rel, "alternate") {
+			return
+		}
+
+		mimeType, _ := link.Attr("type")
+		mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+		if !feedMIMETypes[mimeType] {
+			return
+		}
+
+		href, _ := link.Attr("href")
+		resolved, err := base.Parse(strings.TrimSpace(href))
+		if err != nil || (resolved.Scheme != "http" && resolved.Scheme != "https") {
+			return
+		}
+		resolved.Fragment = ""
+
+		feedURL := resolved.String()
+		if seen[feedURL] {
+			return
+		}
+		seen[feedURL] = true
+
+		title, _ := link.Attr("title")
+		feeds = append(feeds, &pb.DiscoveredFeed{
+			Url:      feedURL,
+			Title:    cleanString(title),
+			MimeType: mimeType,
+			Source:   pb.DiscoverySource_DISCOVERY_SOURCE_LINK_TAG,
+		})
+	})
+
+	return feeds, nil
+}
+
+// hasToken reports whether the space-separated attribute value contains token, ignoring case.
+func hasToken(value, token string) bool {
+	for _, field := range strings.Fields(value) {
+		if strings.EqualFold(field, token) {
+			return true
+		}
+	}
+	return false
+}
+
+// feedMIMEType maps a gofeed feed type to the MIME type advertised for it.
+func feedMIMEType(feedType string) string {
+	switch feedType {
+	case "rss":
+		return "application/rss+xml"
+	case "atom":
+		return "application/atom+xml"
+	case "json":
+		return "application/feed+json"
+	default:
+		return ""
+	}
+}
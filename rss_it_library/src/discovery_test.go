@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+const testHTMLWithLinks = `<!doctype html>
+<html>
+  <head>
+    <title>Example</title>
+    <link rel="alternate" type="application/rss+xml" title="Posts (RSS)" href="/rss.xml">
+    <link rel="alternate" type="application/atom+xml" title="Posts (Atom)" href="atom.xml">
+    <link rel="alternate" type="application/feed+json" title="Posts (JSON)" href="https://cdn.example.com/feed.json">
+    <link rel="Alternate stylesheet" type="application/rss+xml" href="/rss.xml">
+    <link rel="alternate" type="application/json" href="/wp-json/wp/v2/pages/2">
+    <link rel="alternate" type="text/html" hreflang="de" href="/de/">
+    <link rel="stylesheet" href="/style.css">
+  </head>
+  <body>Hello</body>
+</html>`
+
+const testHTMLWithoutLinks = `<!doctype html><html><head><title>Plain</title></head><body>Hello</body></html>`
+
+func TestFeedDiscoverer_LinkTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(testHTMLWithLinks))
+	}))
+	defer server.Close()
+
+	discoverer := NewFeedDiscoverer(gofeed.NewParser, defaultDiscoveryTimeout)
+	response := discoverer.DiscoverFeeds(context.Background(), &pb.DiscoverFeedsRequest{Url: server.URL + "/blog/"})
+
+	if response.Error != nil {
+		t.Fatalf("unexpected error: %v", response.Error)
+	}
+
+	expected := []*pb.DiscoveredFeed{
+		{Url: server.URL + "/rss.xml", Title: "Posts (RSS)", MimeType: "application/rss+xml"},
+		{Url: server.URL + "/blog/atom.xml", Title: "Posts (Atom)", MimeType: "application/atom+xml"},
+		{Url: "https://cdn.example.com/feed.json", Title: "Posts (JSON)", MimeType: "application/feed+json"},
+	}
+	if len(response.Feeds) != len(expected) {
+		t.Fatalf("expected %d feeds, got %d: %v", len(expected), len(response.Feeds), response.Feeds)
+	}
+	for i, want := range expected {
+		got := response.Feeds[i]
+		if got.Url != want.Url || got.Title != want.Title || got.MimeType != want.MimeType {
+			t.Errorf("feed %d: expected %v, got %v", i, want, got)
+		}
+		if got.Source != pb.DiscoverySource_DISCOVERY_SOURCE_LINK_TAG {
+			t.Errorf("feed %d: expected link tag source, got %v", i, got.Source)
+		}
+	}
+}
+
+func TestFeedDiscoverer_WellKnownPaths(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			_, _ = w.Write([]byte(testHTMLWithoutLinks))
+		case "/rss.xml":
+			_, _ = w.Write([]byte(testRSSFeed))
+		case "/feed":
+			// Soft 404 pages must not be reported as feeds.
+			_, _ = w.Write([]byte(testHTMLWithoutLinks))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	discoverer := NewFeedDiscoverer(gofeed.NewParser, defaultDiscoveryTimeout)
+	response := discoverer.DiscoverFeeds(context.Background(), &pb.DiscoverFeedsRequest{Url: server.URL})
+
+	if response.Error != nil {
+		t.Fatalf("unexpected error: %v", response.Error)
+	}
+	if len(response.Feeds) != 1 {
+		t.Fatalf("expected one feed, got %v", response.Feeds)
+	}
+
+	feed := response.Feeds[0]
+	if feed.Url != server.URL+"/rss.xml" {
+		t.Errorf("expected %s/rss.xml, got %s", server.URL, feed.Url)
+	}
+	if feed.Title != "Test Feed" {
+		t.Errorf("expected title from parsed feed, got %q", feed.Title)
+	}
+	if feed.Source != pb.DiscoverySource_DISCOVERY_SOURCE_WELL_KNOWN_PATH {
+		t.Errorf("expected well-known path source, got %v", feed.Source)
+	}
+}
+
+func TestFeedDiscoverer_DirectFeed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	discoverer := NewFeedDiscoverer(gofeed.NewParser, defaultDiscoveryTimeout)
+	response := discoverer.DiscoverFeeds(context.Background(), &pb.DiscoverFeedsRequest{Url: server.URL})
+
+	if response.Error != nil {
+		t.Fatalf("unexpected error: %v", response.Error)
+	}
+	if len(response.Feeds) != 1 || response.Feeds[0].Source != pb.DiscoverySource_DISCOVERY_SOURCE_DIRECT {
+		t.Fatalf("expected the page itself as a direct feed, got %v", response.Feeds)
+	}
+	if response.Feeds[0].MimeType != "application/rss+xml" {
+		t.Errorf("expected RSS MIME type, got %q", response.Feeds[0].MimeType)
+	}
+}
+
+func TestFeedDiscoverer_NoFeeds(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(testHTMLWithoutLinks))
+	}))
+	defer server.Close()
+
+	discoverer := NewFeedDiscoverer(gofeed.NewParser, defaultDiscoveryTimeout)
+	response := discoverer.DiscoverFeeds(context.Background(), &pb.DiscoverFeedsRequest{Url: server.URL})
+
+	if response.Error == nil || response.Error.Kind != pb.ErrorKind_ERROR_KIND_VALIDATION {
+		t.Fatalf("expected validation error, got %v", response.Error)
+	}
+	if len(response.Feeds) != 0 {
+		t.Errorf("expected no feeds, got %v", response.Feeds)
+	}
+}
+
+func TestFeedDiscoverer_EmptyURL(t *testing.T) {
+	discoverer := NewFeedDiscoverer(gofeed.NewParser, defaultDiscoveryTimeout)
+	response := discoverer.DiscoverFeeds(context.Background(), &pb.DiscoverFeedsRequest{Url: "  "})
+
+	if response.Error == nil || response.Error.Kind != pb.ErrorKind_ERROR_KIND_VALIDATION {
+		t.Fatalf("expected validation error, got %v", response.Error)
+	}
+}
+
+func TestFeedDiscoverer_URLWithoutScheme(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	discoverer := NewFeedDiscoverer(func() *gofeed.Parser {
+		parser := gofeed.NewParser()
+		parser.Client = server.Client()
+		return parser
+	}, defaultDiscoveryTimeout)
+	response := discoverer.DiscoverFeeds(context.Background(), &pb.DiscoverFeedsRequest{Url: strings.TrimPrefix(server.URL, "https://")})
+
+	if response.Error != nil {
+		t.Fatalf("unexpected error: %v", response.Error)
+	}
+	if len(response.Feeds) != 1 || response.Feeds[0].Url != server.URL+"/" {
+		t.Errorf("expected the https page as a direct feed, got %v", response.Feeds)
+	}
+
+	invalid := discoverer.DiscoverFeeds(context.Background(), &pb.DiscoverFeedsRequest{Url: "ftp://example.com/"})
+	if invalid.Error == nil || invalid.Error.Kind != pb.ErrorKind_ERROR_KIND_VALIDATION || invalid.Error.Retryable {
+		t.Errorf("expected a non-retryable validation error, got %v", invalid.Error)
+	}
+}
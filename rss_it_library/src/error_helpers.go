@@ -2,14 +2,53 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"io"
 	"net"
+	"net/http"
 	neturl "net/url"
+	"strconv"
 	"strings"
+	"syscall"
+	"time"
+
+	"github.com/mmcdole/gofeed"
 
 	pb "github.com/sunderee/rss-it/proto"
 )
 
+// errTooManyRedirects is wrapped by the shared client's redirect policy when the limit is exceeded.
+var errTooManyRedirects = errors.New("too many redirects")
+
+// statusError reports a non-2xx response, keeping gofeed's HTTPError reachable and any Retry-After hint.
+type statusError struct {
+	gofeed.HTTPError
+	retryAfter time.Duration
+}
+
+func (e *statusError) Unwrap() error { return e.HTTPError }
+
+// newStatusError builds a statusError from resp, parsing its Retry-After header relative to now.
+func newStatusError(resp *http.Response) *statusError {
+	return &statusError{
+		HTTPError: gofeed.HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+		},
+		retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+}
+
+// errorClass describes how a fetch error should be reported to the caller.
+type errorClass struct {
+	kind       pb.ErrorKind
+	retryable  bool
+	httpStatus int
+	retryAfter time.Duration
+}
+
 // newErrorDetail creates a proto.ErrorDetail with trimmed message/url values.
 func newErrorDetail(kind pb.ErrorKind, message, url string) *pb.ErrorDetail {
 	return &pb.ErrorDetail{
@@ -19,36 +58,163 @@ func newErrorDetail(kind pb.ErrorKind, message, url string) *pb.ErrorDetail {
 	}
 }
 
+// newFetchErrorDetail creates a proto.ErrorDetail for a fetch failure, including HTTP status and retry hints.
+func newFetchErrorDetail(err error, url string) *pb.ErrorDetail {
+	class := classifyError(err)
+
+	detail := newErrorDetail(class.kind, err.Error(), url)
+	detail.HttpStatus = int32(class.httpStatus)
+	detail.RetryAfterMs = class.retryAfter.Milliseconds()
+	detail.Retryable = class.retryable
+	return detail
+}
+
 // classifyParseError attempts to categorise an error produced while fetching a feed.
 func classifyParseError(err error) pb.ErrorKind {
+	return classifyError(err).kind
+}
+
+// classifyError maps an error produced while fetching or parsing a feed onto an errorClass.
+func classifyError(err error) errorClass {
 	if err == nil {
-		return pb.ErrorKind_ERROR_KIND_UNKNOWN
+		return errorClass{kind: pb.ErrorKind_ERROR_KIND_UNKNOWN}
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return errorClass{kind: pb.ErrorKind_ERROR_KIND_CANCELLED}
+	}
+
+	if errors.Is(err, errTooManyRedirects) {
+		return errorClass{kind: pb.ErrorKind_ERROR_KIND_TOO_MANY_REDIRECTS}
+	}
+
+	if errors.Is(err, errBlockedByPolicy) {
+		return errorClass{kind: pb.ErrorKind_ERROR_KIND_BLOCKED_BY_POLICY}
+	}
+
+	if errors.Is(err, errBodyTooLarge) {
+		return errorClass{kind: pb.ErrorKind_ERROR_KIND_BODY_TOO_LARGE}
+	}
+
+	var httpErr gofeed.HTTPError
+	if errors.As(err, &httpErr) {
+		return classifyHTTPStatus(err, httpErr.StatusCode)
+	}
+
+	if isTLSError(err) {
+		return errorClass{kind: pb.ErrorKind_ERROR_KIND_TLS}
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return errorClass{
+			kind:      pb.ErrorKind_ERROR_KIND_DNS,
+			retryable: !dnsErr.IsNotFound && (dnsErr.IsTimeout || dnsErr.IsTemporary),
+		}
 	}
 
-	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
-		return pb.ErrorKind_ERROR_KIND_NETWORK
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errorClass{kind: pb.ErrorKind_ERROR_KIND_TIMEOUT, retryable: true}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return errorClass{kind: pb.ErrorKind_ERROR_KIND_TIMEOUT, retryable: true}
+	}
+
+	if errors.Is(err, gofeed.ErrFeedTypeNotDetected) {
+		return errorClass{kind: pb.ErrorKind_ERROR_KIND_NOT_A_FEED}
+	}
+
+	if isTransportError(err) {
+		// Connection refused/reset and similar transport failures are usually transient on mobile networks.
+		return errorClass{kind: pb.ErrorKind_ERROR_KIND_NETWORK, retryable: true}
 	}
 
 	var urlErr *neturl.Error
 	if errors.As(err, &urlErr) {
-		if errors.Is(urlErr.Err, context.Canceled) || errors.Is(urlErr.Err, context.DeadlineExceeded) {
-			return pb.ErrorKind_ERROR_KIND_NETWORK
-		}
+		// Anything else the client rejects, such as an unsupported scheme, fails the same way on every attempt.
+		return errorClass{kind: pb.ErrorKind_ERROR_KIND_NETWORK}
+	}
 
-		if netErr, ok := urlErr.Err.(net.Error); ok {
-			if netErr.Timeout() || netErr.Temporary() {
-				return pb.ErrorKind_ERROR_KIND_NETWORK
-			}
-			return pb.ErrorKind_ERROR_KIND_NETWORK
-		}
+	return errorClass{kind: pb.ErrorKind_ERROR_KIND_PARSING}
+}
 
-		return pb.ErrorKind_ERROR_KIND_NETWORK
+// isTransportError reports whether err comes from the connection itself rather than from the request. A
+// *url.Error implements net.Error on its own, so only the error it wraps is inspected.
+func isTransportError(err error) bool {
+	var urlErr *neturl.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
 	}
 
 	var netErr net.Error
-	if errors.As(err, &netErr) {
-		return pb.ErrorKind_ERROR_KIND_NETWORK
+	return errors.As(err, &netErr) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// classifyHTTPStatus classifies a non-2xx response, marking rate limits and transient server errors retryable.
+func classifyHTTPStatus(err error, statusCode int) errorClass {
+	class := errorClass{
+		kind:       pb.ErrorKind_ERROR_KIND_HTTP_STATUS,
+		httpStatus: statusCode,
 	}
 
-	return pb.ErrorKind_ERROR_KIND_PARSING
+	var withRetry *statusError
+	if errors.As(err, &withRetry) {
+		class.retryAfter = withRetry.retryAfter
+	}
+
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		class.kind = pb.ErrorKind_ERROR_KIND_RATE_LIMITED
+		class.retryable = true
+	case http.StatusRequestTimeout, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		class.retryable = true
+	}
+	return class
+}
+
+// isTLSError reports whether err stems from a failed TLS handshake or certificate verification.
+func isTLSError(err error) bool {
+	var (
+		verificationErr *tls.CertificateVerificationError
+		unknownAuthErr  x509.UnknownAuthorityError
+		hostnameErr     x509.HostnameError
+		invalidCertErr  x509.CertificateInvalidError
+		recordHeaderErr tls.RecordHeaderError
+		alertErr        tls.AlertError
+	)
+	return errors.As(err, &verificationErr) ||
+		errors.As(err, &unknownAuthErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidCertErr) ||
+		errors.As(err, &recordHeaderErr) ||
+		errors.As(err, &alertErr)
+}
+
+// parseRetryAfter interprets a Retry-After header given either as delay seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		if delay := at.Sub(now); delay > 0 {
+			return delay
+		}
+	}
+	return 0
 }
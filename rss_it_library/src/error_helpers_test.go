@@ -2,9 +2,18 @@ package main
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
 	neturl "net/url"
+	"syscall"
 	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
 
 	pb "github.com/sunderee/rss-it/proto"
 )
@@ -29,7 +38,12 @@ func TestClassifyParseError(t *testing.T) {
 		{
 			name:     "deadline exceeded",
 			err:      context.DeadlineExceeded,
-			expected: pb.ErrorKind_ERROR_KIND_NETWORK,
+			expected: pb.ErrorKind_ERROR_KIND_TIMEOUT,
+		},
+		{
+			name:     "context canceled",
+			err:      context.Canceled,
+			expected: pb.ErrorKind_ERROR_KIND_CANCELLED,
 		},
 		{
 			name: "url error timeout",
@@ -38,7 +52,7 @@ func TestClassifyParseError(t *testing.T) {
 				URL: "https://example.com",
 				Err: context.DeadlineExceeded,
 			},
-			expected: pb.ErrorKind_ERROR_KIND_NETWORK,
+			expected: pb.ErrorKind_ERROR_KIND_TIMEOUT,
 		},
 		{
 			name: "net error temporary",
@@ -61,6 +75,174 @@ func TestClassifyParseError(t *testing.T) {
 	}
 }
 
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		kind       pb.ErrorKind
+		retryable  bool
+		httpStatus int
+	}{
+		{
+			name:       "not found",
+			err:        gofeed.HTTPError{StatusCode: http.StatusNotFound, Status: "404 Not Found"},
+			kind:       pb.ErrorKind_ERROR_KIND_HTTP_STATUS,
+			httpStatus: http.StatusNotFound,
+		},
+		{
+			name:       "service unavailable",
+			err:        &statusError{HTTPError: gofeed.HTTPError{StatusCode: http.StatusServiceUnavailable}},
+			kind:       pb.ErrorKind_ERROR_KIND_HTTP_STATUS,
+			retryable:  true,
+			httpStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "rate limited",
+			err:        &statusError{HTTPError: gofeed.HTTPError{StatusCode: http.StatusTooManyRequests}},
+			kind:       pb.ErrorKind_ERROR_KIND_RATE_LIMITED,
+			retryable:  true,
+			httpStatus: http.StatusTooManyRequests,
+		},
+		{
+			name: "too many redirects",
+			err: &neturl.Error{
+				Op:  "Get",
+				URL: "https://example.com",
+				Err: fmt.Errorf("%w: stopped after 3 redirects", errTooManyRedirects),
+			},
+			kind: pb.ErrorKind_ERROR_KIND_TOO_MANY_REDIRECTS,
+		},
+		{
+			name: "dns not found",
+			err: &neturl.Error{
+				Op:  "Get",
+				URL: "https://missing.invalid",
+				Err: &net.DNSError{Err: "no such host", Name: "missing.invalid", IsNotFound: true},
+			},
+			kind: pb.ErrorKind_ERROR_KIND_DNS,
+		},
+		{
+			name:      "dns timeout",
+			err:       &net.DNSError{Err: "i/o timeout", Name: "example.com", IsTimeout: true},
+			kind:      pb.ErrorKind_ERROR_KIND_DNS,
+			retryable: true,
+		},
+		{
+			name: "tls unknown authority",
+			err: &neturl.Error{
+				Op:  "Get",
+				URL: "https://example.com",
+				Err: x509.UnknownAuthorityError{},
+			},
+			kind: pb.ErrorKind_ERROR_KIND_TLS,
+		},
+		{
+			name:      "timeout",
+			err:       context.DeadlineExceeded,
+			kind:      pb.ErrorKind_ERROR_KIND_TIMEOUT,
+			retryable: true,
+		},
+		{
+			name: "not a feed",
+			err:  gofeed.ErrFeedTypeNotDetected,
+			kind: pb.ErrorKind_ERROR_KIND_NOT_A_FEED,
+		},
+		{
+			name:      "connection refused",
+			err:       &neturl.Error{Op: "Get", URL: "https://example.com", Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}},
+			kind:      pb.ErrorKind_ERROR_KIND_NETWORK,
+			retryable: true,
+		},
+		{
+			name:      "connection reset",
+			err:       &neturl.Error{Op: "Get", URL: "https://example.com", Err: fmt.Errorf("read: %w", syscall.ECONNRESET)},
+			kind:      pb.ErrorKind_ERROR_KIND_NETWORK,
+			retryable: true,
+		},
+		{
+			name:      "unexpected EOF",
+			err:       &neturl.Error{Op: "Get", URL: "https://example.com", Err: io.ErrUnexpectedEOF},
+			kind:      pb.ErrorKind_ERROR_KIND_NETWORK,
+			retryable: true,
+		},
+		{
+			name: "unsupported protocol scheme",
+			err:  &neturl.Error{Op: "Get", URL: "example.com", Err: errors.New(`unsupported protocol scheme ""`)},
+			kind: pb.ErrorKind_ERROR_KIND_NETWORK,
+		},
+		{
+			name: "cancelled",
+			err:  fmt.Errorf("fetch: %w", context.Canceled),
+			kind: pb.ErrorKind_ERROR_KIND_CANCELLED,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			class := classifyError(tc.err)
+			if class.kind != tc.kind {
+				t.Errorf("expected kind %v, got %v", tc.kind, class.kind)
+			}
+			if class.retryable != tc.retryable {
+				t.Errorf("expected retryable=%v, got %v", tc.retryable, class.retryable)
+			}
+			if class.httpStatus != tc.httpStatus {
+				t.Errorf("expected status %d, got %d", tc.httpStatus, class.httpStatus)
+			}
+		})
+	}
+}
+
+func TestNewFetchErrorDetail_RetryAfter(t *testing.T) {
+	err := &statusError{
+		HTTPError:  gofeed.HTTPError{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests"},
+		retryAfter: 30 * time.Second,
+	}
+
+	detail := newFetchErrorDetail(err, " https://example.com/feed ")
+
+	if detail.Kind != pb.ErrorKind_ERROR_KIND_RATE_LIMITED {
+		t.Errorf("expected RATE_LIMITED, got %v", detail.Kind)
+	}
+	if detail.HttpStatus != http.StatusTooManyRequests {
+		t.Errorf("expected http status 429, got %d", detail.HttpStatus)
+	}
+	if detail.RetryAfterMs != 30000 {
+		t.Errorf("expected retry_after_ms 30000, got %d", detail.RetryAfterMs)
+	}
+	if !detail.Retryable {
+		t.Error("expected rate limited error to be retryable")
+	}
+	if detail.Url != "https://example.com/feed" {
+		t.Errorf("expected trimmed url, got %q", detail.Url)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{name: "empty", value: "", expected: 0},
+		{name: "seconds", value: " 120 ", expected: 2 * time.Minute},
+		{name: "negative seconds", value: "-5", expected: 0},
+		{name: "http date", value: now.Add(90 * time.Second).Format(http.TimeFormat), expected: 90 * time.Second},
+		{name: "past date", value: now.Add(-time.Hour).Format(http.TimeFormat), expected: 0},
+		{name: "garbage", value: "soon", expected: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := parseRetryAfter(tc.value, now); got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
 type netErrorStub struct {
 	temporary bool
 }
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+// fetchResult captures the outcome of a conditional feed download.
+type fetchResult struct {
+	feed         *gofeed.Feed
+	notModified  bool
+	etag         string
+	lastModified string
+	diagnostics  *pb.FetchDiagnostics
+	jsonFeed     *jsonFeedTranslator
+	xmlBases     *xmlBases
+}
+
+// fetchFeed downloads feedURL using the parser's HTTP settings, sending any supplied cache validators.
+// A 304 response short-circuits parsing and is reported through fetchResult.notModified. Bodies larger
+// than maxBodyBytes are rejected with errBodyTooLarge. The returned result is never nil, so diagnostics
+// are available even when an error is reported.
+func fetchFeed(ctx context.Context, parser *gofeed.Parser, feedURL string, validators *pb.FeedValidators, maxBodyBytes int64) (*fetchResult, error) {
+	trace := newFetchTrace(feedURL)
+	result := &fetchResult{}
+
+	err := result.fetch(httptrace.WithClientTrace(ctx, trace.clientTrace()), parser, feedURL, validators, maxBodyBytes, trace)
+	result.diagnostics = trace.finish()
+	return result, err
+}
+
+// fetch performs the request for fetchFeed, filling in the result as the response is processed.
+func (r *fetchResult) fetch(ctx context.Context, parser *gofeed.Parser, feedURL string, validators *pb.FeedValidators, maxBodyBytes int64, trace *fetchTrace) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feedURL, nil)
+	if err != nil {
+		return err
+	}
+	if parser.UserAgent != "" {
+		req.Header.Set("User-Agent", parser.UserAgent)
+	}
+	if parser.AuthConfig != nil && parser.AuthConfig.Username != "" && parser.AuthConfig.Password != "" {
+		req.SetBasicAuth(parser.AuthConfig.Username, parser.AuthConfig.Password)
+	}
+	if etag := strings.TrimSpace(validators.GetEtag()); etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified := strings.TrimSpace(validators.GetLastModified()); lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+
+	client := parser.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	trace.recordResponse(resp)
+	r.etag = resp.Header.Get("ETag")
+	r.lastModified = resp.Header.Get("Last-Modified")
+
+	if resp.StatusCode == http.StatusNotModified {
+		// Servers may omit validators on 304; keep the caller's values so they can be reused.
+		if r.etag == "" {
+			r.etag = validators.GetEtag()
+		}
+		if r.lastModified == "" {
+			r.lastModified = validators.GetLastModified()
+		}
+		r.notModified = true
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return newStatusError(resp)
+	}
+
+	body, err := readBody(resp, maxBodyBytes)
+	if err != nil {
+		return err
+	}
+	trace.startParse()
+	r.feed, r.jsonFeed, err = parseFeedBody(parser, body)
+	if err != nil {
+		return err
+	}
+	if r.feed.FeedType != "json" {
+		r.xmlBases = scanXMLBases(body, resp.Request.URL)
+	}
+	return nil
+}
+
+// parseFeedBody parses a downloaded feed document. It parses with a copy of parser so the JSON Feed source can be
+// captured without touching the caller's parser.
+func parseFeedBody(parser *gofeed.Parser, body []byte) (*gofeed.Feed, *jsonFeedTranslator, error) {
+	translator := &jsonFeedTranslator{}
+	feedParser := *parser
+	feedParser.JSONTranslator = translator
+
+	feed, err := feedParser.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	return feed, translator, nil
+}
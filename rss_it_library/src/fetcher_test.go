@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+	goproto "google.golang.org/protobuf/proto"
+)
+
+const testRSSFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>Test Feed</title>
+    <link>https://example.com/</link>
+    <description>A feed used in tests</description>
+    <item>
+      <title>First post</title>
+      <link>https://example.com/first</link>
+      <description>Hello world</description>
+    </item>
+  </channel>
+</rss>`
+
+const (
+	testETag         = `"v1"`
+	testLastModified = "Mon, 02 Jan 2006 15:04:05 GMT"
+)
+
+// newConditionalFeedServer serves testRSSFeed and answers 304 when the request carries matching validators.
+func newConditionalFeedServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == testETag || r.Header.Get("If-Modified-Since") == testLastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", testETag)
+		w.Header().Set("Last-Modified", testLastModified)
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFetchFeed_ReturnsValidators(t *testing.T) {
+	server := newConditionalFeedServer(t)
+
+	result, err := fetchFeed(context.Background(), gofeed.NewParser(), server.URL, nil, defaultMaxBodyBytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.notModified {
+		t.Fatal("expected a full response on first fetch")
+	}
+	if result.feed == nil || result.feed.Title != "Test Feed" {
+		t.Fatalf("expected parsed feed, got %+v", result.feed)
+	}
+	if result.etag != testETag {
+		t.Errorf("expected etag %q, got %q", testETag, result.etag)
+	}
+	if result.lastModified != testLastModified {
+		t.Errorf("expected last modified %q, got %q", testLastModified, result.lastModified)
+	}
+}
+
+func TestFetchFeed_NotModified(t *testing.T) {
+	server := newConditionalFeedServer(t)
+
+	tests := []struct {
+		name       string
+		validators *pb.FeedValidators
+	}{
+		{
+			name:       "etag",
+			validators: &pb.FeedValidators{Url: server.URL, Etag: goproto.String(testETag)},
+		},
+		{
+			name:       "last modified",
+			validators: &pb.FeedValidators{Url: server.URL, LastModified: goproto.String(testLastModified)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := fetchFeed(context.Background(), gofeed.NewParser(), server.URL, tc.validators, defaultMaxBodyBytes)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.notModified {
+				t.Fatal("expected not modified result")
+			}
+			if result.feed != nil {
+				t.Error("expected no parsed feed for 304 response")
+			}
+			if result.etag != tc.validators.GetEtag() || result.lastModified != tc.validators.GetLastModified() {
+				t.Errorf("expected request validators to be echoed back, got %q / %q", result.etag, result.lastModified)
+			}
+		})
+	}
+}
+
+func TestFetchFeed_HTTPError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	_, err := fetchFeed(context.Background(), gofeed.NewParser(), server.URL, nil, defaultMaxBodyBytes)
+
+	var httpErr gofeed.HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("expected gofeed.HTTPError, got %T (%v)", err, err)
+	}
+	if httpErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", httpErr.StatusCode)
+	}
+}
+
+func TestFetchFeed_RateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "7")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	_, err := fetchFeed(context.Background(), gofeed.NewParser(), server.URL, nil, defaultMaxBodyBytes)
+
+	detail := newFetchErrorDetail(err, server.URL)
+	if detail.Kind != pb.ErrorKind_ERROR_KIND_RATE_LIMITED || !detail.Retryable {
+		t.Fatalf("expected retryable RATE_LIMITED, got %v (retryable=%v)", detail.Kind, detail.Retryable)
+	}
+	if detail.RetryAfterMs != 7000 {
+		t.Errorf("expected retry_after_ms 7000, got %d", detail.RetryAfterMs)
+	}
+}
+
+func TestRSSParser_ParseFeeds_ConditionalGet(t *testing.T) {
+	server := newConditionalFeedServer(t)
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+
+	first := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}})
+	if first.Status != pb.ParseFeedsStatus_SUCCESS || len(first.Feeds) != 1 {
+		t.Fatalf("expected one parsed feed, got status %v and %d feeds", first.Status, len(first.Feeds))
+	}
+	feed := first.Feeds[0]
+	if feed.NotModified || len(feed.Items) != 1 {
+		t.Fatalf("expected full feed on first fetch, got %+v", feed)
+	}
+
+	second := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{
+		Urls: []string{server.URL},
+		Validators: []*pb.FeedValidators{
+			{Url: server.URL, Etag: feed.Etag, LastModified: feed.LastModified},
+		},
+	})
+	if second.Status != pb.ParseFeedsStatus_SUCCESS || len(second.Feeds) != 1 {
+		t.Fatalf("expected one feed, got status %v and %d feeds", second.Status, len(second.Feeds))
+	}
+	if !second.Feeds[0].NotModified {
+		t.Error("expected feed to be reported as not modified")
+	}
+	if len(second.Feeds[0].Items) != 0 {
+		t.Errorf("expected no items for not modified feed, got %d", len(second.Feeds[0].Items))
+	}
+	if second.Feeds[0].GetEtag() != testETag {
+		t.Errorf("expected etag %q, got %q", testETag, second.Feeds[0].GetEtag())
+	}
+}
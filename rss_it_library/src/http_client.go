@@ -0,0 +1,200 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+const (
+	defaultConnectTimeout        = 10 * time.Second
+	defaultTLSHandshakeTimeout   = 10 * time.Second
+	defaultIdleConnTimeout       = 90 * time.Second
+	defaultMaxIdleConnsPerHost   = 4
+	defaultMaxRedirects          = 10
+	defaultKeepAlive             = 30 * time.Second
+	defaultExpectContinueTimeout = 1 * time.Second
+)
+
+// HTTPClientProvider owns the HTTP client shared by every feed fetch and hands out parsers bound to it.
+type HTTPClientProvider struct {
+	mu        sync.RWMutex
+	client    *http.Client
+	userAgent string
+}
+
+// NewHTTPClientProvider constructs an HTTPClientProvider using the default client configuration.
+func NewHTTPClientProvider() *HTTPClientProvider {
+	client, err := newHTTPClient(nil)
+	if err != nil {
+		// The default configuration has no user-supplied values and cannot fail.
+		panic(err)
+	}
+	return &HTTPClientProvider{client: client}
+}
+
+// Configure replaces the shared client with one built from config. On error the previous client stays active.
+func (p *HTTPClientProvider) Configure(config *pb.ClientConfig) error {
+	client, err := newHTTPClient(config)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	previous := p.client
+	p.client = client
+	p.userAgent = strings.TrimSpace(config.GetUserAgent())
+	p.mu.Unlock()
+
+	if previous != nil {
+		previous.CloseIdleConnections()
+	}
+	return nil
+}
+
+// Client returns the currently configured HTTP client.
+func (p *HTTPClientProvider) Client() *http.Client {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.client
+}
+
+// NewParser returns a gofeed.Parser that fetches through the shared client and user agent.
+func (p *HTTPClientProvider) NewParser() *gofeed.Parser {
+	parser := gofeed.NewParser()
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	parser.Client = p.client
+	if p.userAgent != "" {
+		parser.UserAgent = p.userAgent
+	}
+	return parser
+}
+
+// newHTTPClient builds an http.Client from config, falling back to defaults for unset values.
+func newHTTPClient(config *pb.ClientConfig) (*http.Client, error) {
+	proxy := http.ProxyFromEnvironment
+	var proxyURL *neturl.URL
+	if rawProxy := strings.TrimSpace(config.GetProxyUrl()); rawProxy != "" {
+		var err error
+		proxyURL, err = neturl.Parse(rawProxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("unsupported proxy scheme %q", proxyURL.Scheme)
+		}
+		if proxyURL.Host == "" {
+			return nil, fmt.Errorf("proxy URL %q has no host", rawProxy)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	if config.GetTimeoutMs() < 0 || config.GetConnectTimeoutMs() < 0 || config.GetTlsHandshakeTimeoutMs() < 0 ||
+		config.GetResponseHeaderTimeoutMs() < 0 || config.GetIdleConnTimeoutMs() < 0 {
+		return nil, fmt.Errorf("timeouts must not be negative")
+	}
+	if config.GetHttpCacheMaxBytes() < 0 {
+		return nil, fmt.Errorf("HTTP cache size must not be negative")
+	}
+	if config.GetMaxIdleConnsPerHost() < 0 {
+		return nil, fmt.Errorf("max idle connections per host must not be negative")
+	}
+	hasMaxRedirects := config != nil && config.MaxRedirects != nil
+	if hasMaxRedirects && config.GetMaxRedirects() < 0 {
+		return nil, fmt.Errorf("max redirects must not be negative")
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.GetInsecureSkipVerify(),
+		MinVersion:         tls.VersionTLS12,
+	}
+	if config.GetMinTlsVersion() == pb.TlsVersion_TLS_VERSION_1_3 {
+		tlsConfig.MinVersion = tls.VersionTLS13
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   durationOrDefault(config.GetConnectTimeoutMs(), defaultConnectTimeout),
+		KeepAlive: defaultKeepAlive,
+	}
+
+	policy, err := newNetworkPolicy(config.GetNetworkPolicy(), proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	dialContext := dialer.DialContext
+	if policy != nil {
+		dialContext = policy.dialContext(dialer)
+		if proxyURL == nil {
+			// Environment proxies would connect on our behalf without the address checks.
+			proxy = nil
+		}
+	}
+
+	maxIdlePerHost := int(config.GetMaxIdleConnsPerHost())
+	if maxIdlePerHost == 0 {
+		maxIdlePerHost = defaultMaxIdleConnsPerHost
+	}
+
+	transport := &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   maxIdlePerHost,
+		IdleConnTimeout:       durationOrDefault(config.GetIdleConnTimeoutMs(), defaultIdleConnTimeout),
+		TLSHandshakeTimeout:   durationOrDefault(config.GetTlsHandshakeTimeoutMs(), defaultTLSHandshakeTimeout),
+		ResponseHeaderTimeout: durationOrDefault(config.GetResponseHeaderTimeoutMs(), 0),
+		ExpectContinueTimeout: defaultExpectContinueTimeout,
+		TLSClientConfig:       tlsConfig,
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if cacheDir := strings.TrimSpace(config.GetHttpCacheDir()); cacheDir != "" {
+		cache, err := newDiskCache(transport, cacheDir, config.GetHttpCacheMaxBytes())
+		if err != nil {
+			return nil, err
+		}
+		roundTripper = cache
+	}
+	if policy != nil {
+		roundTripper = &policyTransport{next: roundTripper, policy: policy}
+	}
+
+	maxRedirects := defaultMaxRedirects
+	if hasMaxRedirects {
+		maxRedirects = int(config.GetMaxRedirects())
+	}
+
+	return &http.Client{
+		Transport: roundTripper,
+		Timeout:   durationOrDefault(config.GetTimeoutMs(), 0),
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > maxRedirects {
+				return fmt.Errorf("%w: stopped after %d redirects", errTooManyRedirects, maxRedirects)
+			}
+			return nil
+		},
+	}, nil
+}
+
+// durationOrDefault converts a millisecond value into a time.Duration, using fallback when unset.
+func durationOrDefault(milliseconds int64, fallback time.Duration) time.Duration {
+	if milliseconds <= 0 {
+		return fallback
+	}
+	return time.Duration(milliseconds) * time.Millisecond
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "github.com/sunderee/rss-it/proto"
+	goproto "google.golang.org/protobuf/proto"
+)
+
+func TestNewHTTPClient_Defaults(t *testing.T) {
+	client, err := newHTTPClient(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("expected *http.Transport, got %T", client.Transport)
+	}
+	if transport.TLSHandshakeTimeout != defaultTLSHandshakeTimeout {
+		t.Errorf("expected TLS handshake timeout %v, got %v", defaultTLSHandshakeTimeout, transport.TLSHandshakeTimeout)
+	}
+	if transport.MaxIdleConnsPerHost != defaultMaxIdleConnsPerHost {
+		t.Errorf("expected %d idle connections per host, got %d", defaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	}
+	if client.Timeout != 0 {
+		t.Errorf("expected no overall timeout by default, got %v", client.Timeout)
+	}
+}
+
+func TestNewHTTPClient_AppliesConfig(t *testing.T) {
+	client, err := newHTTPClient(&pb.ClientConfig{
+		TimeoutMs:             1500,
+		TlsHandshakeTimeoutMs: 250,
+		MaxIdleConnsPerHost:   2,
+		ProxyUrl:              "http://proxy.local:8080",
+		InsecureSkipVerify:    true,
+		MinTlsVersion:         pb.TlsVersion_TLS_VERSION_1_3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if client.Timeout != 1500*time.Millisecond {
+		t.Errorf("expected timeout 1.5s, got %v", client.Timeout)
+	}
+
+	transport := client.Transport.(*http.Transport)
+	if transport.TLSHandshakeTimeout != 250*time.Millisecond {
+		t.Errorf("expected TLS handshake timeout 250ms, got %v", transport.TLSHandshakeTimeout)
+	}
+	if transport.MaxIdleConnsPerHost != 2 {
+		t.Errorf("expected 2 idle connections per host, got %d", transport.MaxIdleConnsPerHost)
+	}
+	if !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Error("expected InsecureSkipVerify to be set")
+	}
+	if transport.TLSClientConfig.MinVersion != tls.VersionTLS13 {
+		t.Errorf("expected TLS 1.3 minimum, got %x", transport.TLSClientConfig.MinVersion)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com/feed", nil)
+	proxyURL, err := transport.Proxy(req)
+	if err != nil || proxyURL == nil || proxyURL.Host != "proxy.local:8080" {
+		t.Errorf("expected proxy.local:8080, got %v (err %v)", proxyURL, err)
+	}
+}
+
+func TestNewHTTPClient_RejectsInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *pb.ClientConfig
+	}{
+		{name: "unsupported proxy scheme", config: &pb.ClientConfig{ProxyUrl: "ftp://proxy.local"}},
+		{name: "proxy without host", config: &pb.ClientConfig{ProxyUrl: "http://"}},
+		{name: "negative timeout", config: &pb.ClientConfig{TimeoutMs: -1}},
+		{name: "negative redirects", config: &pb.ClientConfig{MaxRedirects: goproto.Int32(-1)}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := newHTTPClient(tc.config); err == nil {
+				t.Fatal("expected configuration error")
+			}
+		})
+	}
+}
+
+func TestNewHTTPClient_MaxRedirects(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/a":
+			http.Redirect(w, r, server.URL+"/b", http.StatusFound)
+		case "/b":
+			http.Redirect(w, r, server.URL+"/c", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		max       int32
+		expectErr bool
+	}{
+		{name: "redirects allowed", max: 2, expectErr: false},
+		{name: "too many redirects", max: 1, expectErr: true},
+		{name: "redirects disabled", max: 0, expectErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := newHTTPClient(&pb.ClientConfig{MaxRedirects: goproto.Int32(tc.max)})
+			if err != nil {
+				t.Fatalf("unexpected config error: %v", err)
+			}
+
+			resp, err := client.Get(server.URL + "/a")
+			if resp != nil {
+				resp.Body.Close()
+			}
+			if tc.expectErr && err == nil {
+				t.Fatal("expected redirect limit error")
+			}
+			if !tc.expectErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestHTTPClientProvider_ConfigureAppliesToParsers(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	provider := NewHTTPClientProvider()
+	if err := provider.Configure(&pb.ClientConfig{UserAgent: "rss-it-test/1.0"}); err != nil {
+		t.Fatalf("unexpected configure error: %v", err)
+	}
+
+	parser := provider.NewParser()
+	if parser.Client != provider.Client() {
+		t.Error("expected parser to use the shared client")
+	}
+
+	if _, err := fetchFeed(context.Background(), parser, server.URL, nil, defaultMaxBodyBytes); err != nil {
+		t.Fatalf("unexpected fetch error: %v", err)
+	}
+	if userAgent != "rss-it-test/1.0" {
+		t.Errorf("expected configured user agent, got %q", userAgent)
+	}
+}
+
+func TestHTTPClientProvider_ConfigureKeepsClientOnError(t *testing.T) {
+	provider := NewHTTPClientProvider()
+	previous := provider.Client()
+
+	if err := provider.Configure(&pb.ClientConfig{ProxyUrl: "ftp://proxy.local"}); err == nil {
+		t.Fatal("expected configure error")
+	}
+	if provider.Client() != previous {
+		t.Error("expected previous client to remain active after a failed configure")
+	}
+}
+
+func TestApplyClientConfig_RejectsBeforeApplying(t *testing.T) {
+	provider := NewHTTPClientProvider()
+	cache := NewFeedCache(time.Minute, 0)
+	previous := provider.Client()
+
+	if err := applyClientConfig(provider, cache, &pb.ClientConfig{UserAgent: "changed", CacheTtlMs: -1}); err == nil {
+		t.Fatal("expected configure error for invalid cache limits")
+	}
+	if provider.Client() != previous {
+		t.Error("expected previous client to remain active when the cache limits are invalid")
+	}
+
+	if err := applyClientConfig(provider, cache, &pb.ClientConfig{ProxyUrl: "ftp://proxy.local", CacheTtlMs: 1000}); err == nil {
+		t.Fatal("expected configure error for an invalid proxy")
+	}
+	if cache.ttl != time.Minute {
+		t.Errorf("expected the cache to keep its TTL when the client config is invalid, got %v", cache.ttl)
+	}
+}
@@ -0,0 +1,533 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultHTTPCacheMaxBytes = 64 << 20
+	// maxHeuristicFreshness caps the freshness given to responses that only carry Last-Modified.
+	maxHeuristicFreshness = 24 * time.Hour
+	// httpCacheStatusHeader is set on every response passing through diskCache to tell the fetch layer how it was
+	// served. It never reaches the network.
+	httpCacheStatusHeader = "X-Rss-It-Cache"
+	httpCacheFileSuffix   = ".entry"
+	httpCacheTempPrefix   = "tmp-"
+)
+
+const (
+	httpCacheMiss        = "miss"
+	httpCacheHit         = "hit"
+	httpCacheRevalidated = "revalidated"
+)
+
+// diskCache is an http.RoundTripper that keeps GET responses on disk following RFC 9111 as a private cache. Fresh
+// responses are served without a request, stale ones are revalidated with their ETag or Last-Modified, and the
+// least recently used entries are evicted once the directory grows beyond maxBytes.
+type diskCache struct {
+	next     http.RoundTripper
+	dir      string
+	maxBytes int64
+	now      func() time.Time
+
+	mu    sync.Mutex
+	size  int64
+	index map[string]*diskCacheFile
+}
+
+// diskCacheFile tracks the size and last use of a stored entry for eviction.
+type diskCacheFile struct {
+	size int64
+	used time.Time
+}
+
+// diskCacheMeta is the metadata stored ahead of a cached response body.
+type diskCacheMeta struct {
+	URL          string            `json:"url"`
+	StatusCode   int               `json:"status_code"`
+	Header       http.Header       `json:"header"`
+	Vary         map[string]string `json:"vary,omitempty"`
+	RequestTime  time.Time         `json:"request_time"`
+	ResponseTime time.Time         `json:"response_time"`
+}
+
+// diskCacheEntry is a cached response loaded from disk.
+type diskCacheEntry struct {
+	meta diskCacheMeta
+	body []byte
+}
+
+// newDiskCache wraps next with a cache stored in dir, creating the directory and indexing existing entries.
+func newDiskCache(next http.RoundTripper, dir string, maxBytes int64) (*diskCache, error) {
+	if maxBytes <= 0 {
+		maxBytes = defaultHTTPCacheMaxBytes
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create HTTP cache directory: %w", err)
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read HTTP cache directory: %w", err)
+	}
+
+	cache := &diskCache{
+		next:     next,
+		dir:      dir,
+		maxBytes: maxBytes,
+		now:      time.Now,
+		index:    make(map[string]*diskCacheFile),
+	}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		if strings.HasPrefix(file.Name(), httpCacheTempPrefix) {
+			// Left behind by a write interrupted when the app was killed.
+			_ = os.Remove(filepath.Join(dir, file.Name()))
+			continue
+		}
+		key, ok := strings.CutSuffix(file.Name(), httpCacheFileSuffix)
+		if !ok {
+			continue
+		}
+		info, err := file.Info()
+		if err != nil {
+			continue
+		}
+		cache.index[key] = &diskCacheFile{size: info.Size(), used: info.ModTime()}
+		cache.size += info.Size()
+	}
+
+	cache.mu.Lock()
+	cache.evictLocked()
+	cache.mu.Unlock()
+	return cache, nil
+}
+
+// RoundTrip answers req from the cache when a fresh entry exists, revalidates stale entries and stores cacheable
+// responses as they are read.
+func (c *diskCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestDirectives := cacheControl(req.Header)
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" || requestDirectives.has("no-store") {
+		return c.next.RoundTrip(req)
+	}
+
+	key := c.key(req)
+	entry := c.load(key)
+	if entry != nil && !entry.matchesVary(req) {
+		entry = nil
+	}
+
+	if entry != nil && entry.fresh(requestDirectives, c.now()) {
+		c.touch(key)
+		return entry.response(req, httpCacheHit, c.now()), nil
+	}
+
+	forward := req
+	etag, lastModified := "", ""
+	if entry != nil {
+		etag, lastModified = entry.meta.Header.Get("ETag"), entry.meta.Header.Get("Last-Modified")
+	}
+	if etag != "" || lastModified != "" {
+		// Revalidate with our own validators; the caller's conditionals are evaluated against the entry afterwards.
+		forward = req.Clone(req.Context())
+		forward.Header.Del("If-None-Match")
+		forward.Header.Del("If-Modified-Since")
+		if etag != "" {
+			forward.Header.Set("If-None-Match", etag)
+		}
+		if lastModified != "" {
+			forward.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	requestTime := c.now()
+	resp, err := c.next.RoundTrip(forward)
+	if err != nil {
+		return nil, err
+	}
+	responseTime := c.now()
+
+	if resp.StatusCode == http.StatusNotModified && forward != req {
+		_ = resp.Body.Close()
+		entry.refresh(resp.Header, requestTime, responseTime)
+		c.store(key, entry)
+		return entry.response(req, httpCacheRevalidated, responseTime), nil
+	}
+
+	resp.Header.Set(httpCacheStatusHeader, httpCacheMiss)
+	if !storable(req, requestDirectives, resp) {
+		return resp, nil
+	}
+
+	meta := diskCacheMeta{
+		URL:          req.URL.String(),
+		StatusCode:   resp.StatusCode,
+		Header:       resp.Header.Clone(),
+		Vary:         varyValues(req, resp.Header),
+		RequestTime:  requestTime,
+		ResponseTime: responseTime,
+	}
+	meta.Header.Del(httpCacheStatusHeader)
+	meta.Header.Del("Content-Length")
+	resp.Body = &diskCacheWriter{
+		ReadCloser: resp.Body,
+		limit:      c.maxBytes / 4,
+		onComplete: func(body []byte) {
+			c.store(key, &diskCacheEntry{meta: meta, body: body})
+		},
+	}
+	return resp, nil
+}
+
+// key derives the file name for a request's URL.
+func (c *diskCache) key(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.URL.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// path returns the location of the entry stored under key.
+func (c *diskCache) path(key string) string {
+	return filepath.Join(c.dir, key+httpCacheFileSuffix)
+}
+
+// load reads the entry stored under key, or returns nil when it is missing or unreadable.
+func (c *diskCache) load(key string) *diskCacheEntry {
+	file, err := os.Open(c.path(key))
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	header, err := reader.ReadBytes('\n')
+	if err != nil {
+		return nil
+	}
+	entry := &diskCacheEntry{}
+	if err := json.Unmarshal(header, &entry.meta); err != nil {
+		return nil
+	}
+	if entry.body, err = io.ReadAll(reader); err != nil {
+		return nil
+	}
+	return entry
+}
+
+// store writes entry under key, replacing the file atomically, and evicts old entries if the cache is over size.
+// Failures are ignored: the cache is an optimisation and the response has already been served.
+func (c *diskCache) store(key string, entry *diskCacheEntry) {
+	header, err := json.Marshal(entry.meta)
+	if err != nil {
+		return
+	}
+
+	temp, err := os.CreateTemp(c.dir, httpCacheTempPrefix+"*")
+	if err != nil {
+		return
+	}
+	_, writeErr := temp.Write(append(append(header, '\n'), entry.body...))
+	closeErr := temp.Close()
+	if writeErr != nil || closeErr != nil {
+		_ = os.Remove(temp.Name())
+		return
+	}
+	if err := os.Rename(temp.Name(), c.path(key)); err != nil {
+		_ = os.Remove(temp.Name())
+		return
+	}
+
+	size := int64(len(header) + 1 + len(entry.body))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if previous, ok := c.index[key]; ok {
+		c.size -= previous.size
+	}
+	c.index[key] = &diskCacheFile{size: size, used: c.now()}
+	c.size += size
+	c.evictLocked()
+}
+
+// touch records a cache hit so the entry is evicted last.
+func (c *diskCache) touch(key string) {
+	now := c.now()
+	_ = os.Chtimes(c.path(key), now, now)
+
+	c.mu.Lock()
+	if file, ok := c.index[key]; ok {
+		file.used = now
+	}
+	c.mu.Unlock()
+}
+
+// evictLocked removes least recently used entries until the cache fits maxBytes. c.mu must be held.
+func (c *diskCache) evictLocked() {
+	if c.size <= c.maxBytes {
+		return
+	}
+
+	keys := make([]string, 0, len(c.index))
+	for key := range c.index {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return c.index[keys[i]].used.Before(c.index[keys[j]].used)
+	})
+
+	for _, key := range keys {
+		if c.size <= c.maxBytes {
+			return
+		}
+		_ = os.Remove(c.path(key))
+		c.size -= c.index[key].size
+		delete(c.index, key)
+	}
+}
+
+// fresh reports whether the entry may be served without revalidation (RFC 9111 section 4.2), taking the
+// request's own Cache-Control directives into account.
+func (e *diskCacheEntry) fresh(requestDirectives directives, now time.Time) bool {
+	responseDirectives := cacheControl(e.meta.Header)
+	if responseDirectives.has("no-cache") || requestDirectives.has("no-cache") || e.meta.Header.Get("Pragma") == "no-cache" {
+		return false
+	}
+
+	age := e.age(now)
+	lifetime := e.freshnessLifetime()
+	if maxAge, ok := requestDirectives.seconds("max-age"); ok {
+		lifetime = min(lifetime, maxAge)
+	}
+	return lifetime > age
+}
+
+// freshnessLifetime returns how long the response stays fresh: max-age, then Expires, then a heuristic of 10% of
+// the time since Last-Modified.
+func (e *diskCacheEntry) freshnessLifetime() time.Duration {
+	if maxAge, ok := cacheControl(e.meta.Header).seconds("max-age"); ok {
+		return maxAge
+	}
+
+	date := e.date()
+	if expiresHeader := e.meta.Header.Get("Expires"); expiresHeader != "" {
+		expires, err := http.ParseTime(expiresHeader)
+		if err != nil {
+			// An invalid Expires, such as "0", means already expired.
+			return 0
+		}
+		return expires.Sub(date)
+	}
+
+	if lastModified, err := http.ParseTime(e.meta.Header.Get("Last-Modified")); err == nil && lastModified.Before(date) {
+		return min(date.Sub(lastModified)/10, maxHeuristicFreshness)
+	}
+	return 0
+}
+
+// age computes the current age of the entry (RFC 9111 section 4.2.3).
+func (e *diskCacheEntry) age(now time.Time) time.Duration {
+	apparentAge := max(0, e.meta.ResponseTime.Sub(e.date()))
+
+	var ageValue time.Duration
+	if seconds, err := strconv.ParseInt(strings.TrimSpace(e.meta.Header.Get("Age")), 10, 64); err == nil && seconds > 0 {
+		ageValue = time.Duration(seconds) * time.Second
+	}
+	correctedAge := ageValue + e.meta.ResponseTime.Sub(e.meta.RequestTime)
+
+	return max(apparentAge, correctedAge) + now.Sub(e.meta.ResponseTime)
+}
+
+// date returns the response's Date header, falling back to when it was received.
+func (e *diskCacheEntry) date() time.Time {
+	if date, err := http.ParseTime(e.meta.Header.Get("Date")); err == nil {
+		return date
+	}
+	return e.meta.ResponseTime
+}
+
+// matchesVary reports whether req selects this entry under the response's Vary header.
+func (e *diskCacheEntry) matchesVary(req *http.Request) bool {
+	for name, value := range e.meta.Vary {
+		if req.Header.Get(name) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// refresh applies the headers of a 304 response to the entry (RFC 9111 section 4.3.4).
+func (e *diskCacheEntry) refresh(header http.Header, requestTime, responseTime time.Time) {
+	for name, values := range header {
+		if name == "Content-Length" {
+			continue
+		}
+		e.meta.Header[name] = values
+	}
+	e.meta.RequestTime = requestTime
+	e.meta.ResponseTime = responseTime
+}
+
+// response builds the reply to req from the entry, answering the caller's own conditional headers with 304.
+func (e *diskCacheEntry) response(req *http.Request, status string, now time.Time) *http.Response {
+	header := e.meta.Header.Clone()
+	header.Set("Age", strconv.FormatInt(int64(e.age(now)/time.Second), 10))
+	header.Set(httpCacheStatusHeader, status)
+
+	resp := &http.Response{
+		Status:     fmt.Sprintf("%d %s", e.meta.StatusCode, http.StatusText(e.meta.StatusCode)),
+		StatusCode: e.meta.StatusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     header,
+		Request:    req,
+	}
+
+	if e.satisfies(req) {
+		resp.StatusCode = http.StatusNotModified
+		resp.Status = fmt.Sprintf("%d %s", http.StatusNotModified, http.StatusText(http.StatusNotModified))
+		resp.Body = http.NoBody
+		return resp
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(e.body))
+	resp.ContentLength = int64(len(e.body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(e.body)))
+	return resp
+}
+
+// satisfies reports whether the caller's If-None-Match or If-Modified-Since already matches the entry.
+func (e *diskCacheEntry) satisfies(req *http.Request) bool {
+	if ifNoneMatch := req.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		etag := strings.TrimPrefix(e.meta.Header.Get("ETag"), "W/")
+		if etag == "" {
+			return false
+		}
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	since, err := http.ParseTime(req.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	lastModified, err := http.ParseTime(e.meta.Header.Get("Last-Modified"))
+	return err == nil && !lastModified.After(since)
+}
+
+// storable reports whether resp may be stored (RFC 9111 section 3). Only complete 200 and 203 responses that can
+// either be served fresh or revalidated are kept.
+func storable(req *http.Request, requestDirectives directives, resp *http.Response) bool {
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNonAuthoritativeInfo {
+		return false
+	}
+	if requestDirectives.has("no-store") || cacheControl(resp.Header).has("no-store") {
+		return false
+	}
+	if strings.Contains(resp.Header.Get("Vary"), "*") {
+		return false
+	}
+
+	entry := &diskCacheEntry{meta: diskCacheMeta{Header: resp.Header, RequestTime: time.Now(), ResponseTime: time.Now()}}
+	return entry.freshnessLifetime() > 0 || resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""
+}
+
+// varyValues records the request headers named by the response's Vary header.
+func varyValues(req *http.Request, header http.Header) map[string]string {
+	values := make(map[string]string)
+	for _, field := range header.Values("Vary") {
+		for _, name := range strings.Split(field, ",") {
+			if name = http.CanonicalHeaderKey(strings.TrimSpace(name)); name != "" {
+				values[name] = req.Header.Get(name)
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// directives holds parsed Cache-Control directives, keyed by lower-case name.
+type directives map[string]string
+
+// cacheControl parses the Cache-Control header.
+func cacheControl(header http.Header) directives {
+	parsed := make(directives)
+	for _, field := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(field, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+				parsed[name] = strings.Trim(strings.TrimSpace(value), `"`)
+			}
+		}
+	}
+	return parsed
+}
+
+// has reports whether the directive is present.
+func (d directives) has(name string) bool {
+	_, ok := d[name]
+	return ok
+}
+
+// seconds returns a delta-seconds directive such as max-age as a duration.
+func (d directives) seconds(name string) (time.Duration, bool) {
+	value, ok := d[name]
+	if !ok {
+		return 0, false
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// diskCacheWriter buffers a response body as it is read and hands it to onComplete once the body has been read to
+// the end. Bodies larger than limit, or closed early, are not stored.
+type diskCacheWriter struct {
+	io.ReadCloser
+	limit      int64
+	buffer     bytes.Buffer
+	overflow   bool
+	done       bool
+	onComplete func([]byte)
+}
+
+func (w *diskCacheWriter) Read(p []byte) (int, error) {
+	n, err := w.ReadCloser.Read(p)
+	if n > 0 && !w.overflow {
+		if int64(w.buffer.Len()+n) > w.limit {
+			w.overflow = true
+			w.buffer = bytes.Buffer{}
+		} else {
+			w.buffer.Write(p[:n])
+		}
+	}
+	if err == io.EOF && !w.overflow && !w.done {
+		w.done = true
+		w.onComplete(w.buffer.Bytes())
+	}
+	return n, err
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+	goproto "google.golang.org/protobuf/proto"
+)
+
+// newCachedParser returns a parser whose client caches responses in dir.
+func newCachedParser(t *testing.T, dir string, maxBytes int64) *gofeed.Parser {
+	t.Helper()
+	client, err := newHTTPClient(&pb.ClientConfig{HttpCacheDir: dir, HttpCacheMaxBytes: maxBytes})
+	if err != nil {
+		t.Fatalf("failed to build client: %v", err)
+	}
+	parser := gofeed.NewParser()
+	parser.Client = client
+	return parser
+}
+
+func TestDiskCache_ServesFreshResponses(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Cache-Control", "max-age=300")
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	first, err := fetchFeed(context.Background(), newCachedParser(t, dir, 0), server.URL, nil, defaultMaxBodyBytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.diagnostics.HttpCache != pb.HttpCacheStatus_HTTP_CACHE_STATUS_MISS {
+		t.Errorf("expected a cache miss, got %v", first.diagnostics.HttpCache)
+	}
+
+	// A new client over the same directory stands in for a cold start.
+	second, err := fetchFeed(context.Background(), newCachedParser(t, dir, 0), server.URL, nil, defaultMaxBodyBytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if second.diagnostics.HttpCache != pb.HttpCacheStatus_HTTP_CACHE_STATUS_HIT || second.feed.Title != first.feed.Title {
+		t.Errorf("expected a cache hit with the same feed, got %v (%q)", second.diagnostics.HttpCache, second.feed.Title)
+	}
+	if second.diagnostics.BytesDownloaded != 0 {
+		t.Errorf("expected no bytes downloaded for a cache hit, got %d", second.diagnostics.BytesDownloaded)
+	}
+	if hits.Load() != 1 {
+		t.Errorf("expected one origin request, got %d", hits.Load())
+	}
+}
+
+func TestDiskCache_RevalidatesStaleResponses(t *testing.T) {
+	var hits, conditional atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditional.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	parser := newCachedParser(t, t.TempDir(), 0)
+	if _, err := fetchFeed(context.Background(), parser, server.URL, nil, defaultMaxBodyBytes); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := fetchFeed(context.Background(), parser, server.URL, nil, defaultMaxBodyBytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.notModified || result.feed == nil || result.feed.Title != "Test Feed" {
+		t.Fatalf("expected the cached body after revalidation, got %+v", result)
+	}
+	if result.diagnostics.HttpCache != pb.HttpCacheStatus_HTTP_CACHE_STATUS_REVALIDATED || conditional.Load() != 1 {
+		t.Errorf("expected a revalidation, got %v after %d conditional requests", result.diagnostics.HttpCache, conditional.Load())
+	}
+
+	// The caller's own validators are answered from the revalidated entry.
+	result, err = fetchFeed(context.Background(), parser, server.URL, &pb.FeedValidators{Url: server.URL, Etag: goproto.String(`"v1"`)}, defaultMaxBodyBytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.notModified || result.etag != `"v1"` {
+		t.Errorf("expected 304 for matching caller validators, got %+v", result)
+	}
+	if hits.Load() != 3 {
+		t.Errorf("expected every request to reach the origin for revalidation, got %d", hits.Load())
+	}
+}
+
+func TestDiskCache_RespectsNoStore(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Cache-Control", "no-store, max-age=300")
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	parser := newCachedParser(t, dir, 0)
+	for range 2 {
+		if _, err := fetchFeed(context.Background(), parser, server.URL, nil, defaultMaxBodyBytes); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if hits.Load() != 2 {
+		t.Errorf("expected no-store responses to be refetched, got %d requests", hits.Load())
+	}
+	if files, _ := os.ReadDir(dir); len(files) != 0 {
+		t.Errorf("expected nothing stored, found %d files", len(files))
+	}
+}
+
+func TestDiskCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=300")
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	transport, err := newDiskCache(http.DefaultTransport, dir, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now := time.Now()
+	transport.now = func() time.Time { return now }
+	client := &http.Client{Transport: transport}
+
+	get := func(path string) {
+		resp, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		now = now.Add(time.Second)
+	}
+
+	// Size the cache to hold four entries; a single entry may use at most a quarter of it.
+	get("/a")
+	transport.maxBytes = transport.size * 4
+	get("/b")
+	get("/c")
+	get("/d")
+	get("/a")
+	get("/e")
+
+	if len(transport.index) != 4 {
+		t.Fatalf("expected four entries to remain, got %d", len(transport.index))
+	}
+	for _, path := range []string{"/a", "/c", "/d", "/e"} {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		if _, ok := transport.index[transport.key(req)]; !ok {
+			t.Errorf("expected %s to remain cached", path)
+		}
+	}
+	if files, _ := os.ReadDir(dir); len(files) != 4 {
+		t.Errorf("expected evicted entries to be removed from disk, found %d files", len(files))
+	}
+}
+
+func TestDiskCacheEntry_Freshness(t *testing.T) {
+	received := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	entry := func(header http.Header) *diskCacheEntry {
+		return &diskCacheEntry{meta: diskCacheMeta{Header: header, RequestTime: received, ResponseTime: received}}
+	}
+
+	tests := []struct {
+		name     string
+		header   http.Header
+		request  directives
+		elapsed  time.Duration
+		expected bool
+	}{
+		{name: "max-age", header: http.Header{"Cache-Control": {"max-age=60"}}, elapsed: 30 * time.Second, expected: true},
+		{name: "max-age elapsed", header: http.Header{"Cache-Control": {"max-age=60"}}, elapsed: 90 * time.Second},
+		{name: "age header", header: http.Header{"Cache-Control": {"max-age=60"}, "Age": {"45"}}, elapsed: 30 * time.Second},
+		{name: "expires", header: http.Header{"Date": {received.Format(http.TimeFormat)}, "Expires": {received.Add(time.Hour).Format(http.TimeFormat)}}, elapsed: time.Minute, expected: true},
+		{name: "invalid expires", header: http.Header{"Expires": {"0"}}, elapsed: 0},
+		{name: "heuristic", header: http.Header{"Date": {received.Format(http.TimeFormat)}, "Last-Modified": {received.Add(-10 * time.Hour).Format(http.TimeFormat)}}, elapsed: 30 * time.Minute, expected: true},
+		{name: "no-cache", header: http.Header{"Cache-Control": {"max-age=60, no-cache"}}, elapsed: 0},
+		{name: "request max-age", header: http.Header{"Cache-Control": {"max-age=60"}}, request: directives{"max-age": "10"}, elapsed: 30 * time.Second},
+		{name: "request no-cache", header: http.Header{"Cache-Control": {"max-age=60"}}, request: directives{"no-cache": ""}, elapsed: 0},
+	}
+
+	for _, tc := range tests {
+		if got := entry(tc.header).fresh(tc.request, received.Add(tc.elapsed)); got != tc.expected {
+			t.Errorf("%s: fresh = %v, want %v", tc.name, got, tc.expected)
+		}
+	}
+}
+
+func TestNewHTTPClient_RejectsNegativeHTTPCacheSize(t *testing.T) {
+	if _, err := newHTTPClient(&pb.ClientConfig{HttpCacheDir: t.TempDir(), HttpCacheMaxBytes: -1}); err == nil {
+		t.Error("expected error for negative HTTP cache size")
+	}
+}
@@ -0,0 +1,346 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"  // register decoders used to read icon dimensions
+	_ "image/jpeg" // register decoders used to read icon dimensions
+	_ "image/png"  // register decoders used to read icon dimensions
+	"io"
+	"mime"
+	"net/http"
+	neturl "net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mmcdole/gofeed"
+
+	pb "github.com/sunderee/rss-it/proto"
+	goproto "google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultIconTimeout  = 15 * time.Second
+	defaultIconMaxBytes = 256 << 10
+)
+
+// IconResolver finds the best icon for a feed or site, checking the feed's own artwork before the site's
+// declared icons and the conventional /favicon.ico.
+type IconResolver struct {
+	newParser func() *gofeed.Parser
+	timeout   time.Duration
+}
+
+// NewIconResolver constructs an IconResolver using the supplied parser factory and timeout.
+func NewIconResolver(newParser func() *gofeed.Parser, timeout time.Duration) *IconResolver {
+	if newParser == nil {
+		newParser = gofeed.NewParser
+	}
+	if timeout <= 0 {
+		timeout = defaultIconTimeout
+	}
+	return &IconResolver{
+		newParser: newParser,
+		timeout:   timeout,
+	}
+}
+
+// ResolveIcon fetches the requested feed or page and returns the best icon candidate, along with every candidate
+// found in preference order. With include_data set, the icon is downloaded and candidates larger than max_bytes
+// are passed over.
+func (r *IconResolver) ResolveIcon(ctx context.Context, request *pb.ResolveIconRequest) *pb.ResolveIconResponse {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	response := &pb.ResolveIconResponse{Candidates: make([]*pb.FeedIcon, 0)}
+
+	if request == nil {
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, "resolve icon request is empty", "")
+		return response
+	}
+
+	targetURL := strings.TrimSpace(request.GetUrl())
+	if targetURL == "" {
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, "URL is empty", "")
+		return response
+	}
+
+	canonicalURL, err := normalizeFeedURL(targetURL, false)
+	if err != nil {
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, err.Error(), targetURL)
+		return response
+	}
+
+	resolveCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	parser := r.newParser()
+
+	body, finalURL, err := fetchPage(resolveCtx, parser, canonicalURL)
+	if err != nil {
+		response.Error = newFetchErrorDetail(err, targetURL)
+		response.Error.CanonicalUrl = canonicalURL
+		return response
+	}
+
+	candidates := make([]*pb.FeedIcon, 0)
+	sitePage, siteURL := body, finalURL
+
+	if feed, translator, err := parseFeedBody(parser, body); err == nil {
+		resolver := urlResolver{base: finalURL}
+		candidates = append(candidates, feedIcons(feed, translator, resolver.rebase(feed.Link))...)
+
+		// The feed itself has no <link rel="icon">; look at the site it belongs to instead, falling back to the
+		// root of the feed's host when the feed links nowhere else.
+		sitePage, siteURL = nil, resolver.resolveURL(feed.Link)
+		if siteURL == nil || siteURL.String() == finalURL.String() {
+			siteURL = finalURL.ResolveReference(&neturl.URL{Path: "/"})
+		}
+		if page, pageURL, err := fetchPage(resolveCtx, parser, siteURL.String()); err == nil {
+			sitePage, siteURL = page, pageURL
+		}
+	}
+
+	if sitePage != nil {
+		candidates = append(candidates, linkedIcons(sitePage, siteURL)...)
+	}
+	candidates = append(candidates, &pb.FeedIcon{
+		Url:    siteURL.ResolveReference(&neturl.URL{Path: "/favicon.ico"}).String(),
+		Source: pb.IconSource_ICON_SOURCE_FAVICON,
+	})
+	candidates = dedupeIcons(candidates)
+	response.Candidates = candidates
+
+	maxBytes := request.GetMaxBytes()
+	if maxBytes <= 0 {
+		maxBytes = defaultIconMaxBytes
+	}
+	maxBytes = min(maxBytes, maxDiscoveryPageBytes)
+
+	for _, candidate := range candidates {
+		// Declared icons are trusted as-is unless their bytes were asked for; /favicon.ico is only a guess.
+		if !request.GetIncludeData() && candidate.Source != pb.IconSource_ICON_SOURCE_FAVICON {
+			response.Icon = candidate
+			return response
+		}
+
+		icon, err := downloadIcon(resolveCtx, parser, candidate, maxBytes)
+		if err != nil {
+			continue
+		}
+		if !request.GetIncludeData() {
+			icon.Data = nil
+		}
+		response.Icon = icon
+		return response
+	}
+
+	response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, "no icon found", targetURL)
+	return response
+}
+
+// feedIcons lists the artwork a feed declares for itself: its image or Atom logo/icon, the JSON Feed favicon
+// and podcast artwork.
+func feedIcons(feed *gofeed.Feed, translator *jsonFeedTranslator, resolver urlResolver) []*pb.FeedIcon {
+	urls := make([]string, 0, 3)
+	if feed.Image != nil {
+		urls = append(urls, feed.Image.URL)
+	}
+	if translator != nil && translator.source != nil {
+		urls = append(urls, translator.source.Favicon)
+	}
+	if feed.ITunesExt != nil {
+		urls = append(urls, feed.ITunesExt.Image)
+	}
+
+	icons := make([]*pb.FeedIcon, 0, len(urls))
+	for _, raw := range urls {
+		if iconURL := resolver.resolve(raw); iconURL != "" {
+			icons = append(icons, &pb.FeedIcon{
+				Url:      iconURL,
+				MimeType: iconMIMEType("", iconURL),
+				Source:   pb.IconSource_ICON_SOURCE_FEED,
+			})
+		}
+	}
+	return icons
+}
+
+// linkedIcons extracts <link rel="icon"> and apple-touch-icon declarations from an HTML page, largest first.
+func linkedIcons(page []byte, pageURL *neturl.URL) []*pb.FeedIcon {
+	document, err := goquery.NewDocumentFromReader(bytes.NewReader(page))
+	if err != nil {
+		return nil
+	}
+
+	resolver := urlResolver{base: pageURL}
+	if href, ok := document.Find("base[href]").First().Attr("href"); ok {
+		resolver = resolver.rebase(href)
+	}
+
+	icons := make([]*pb.FeedIcon, 0)
+	document.Find("link[rel][href]").Each(func(_ int, link *goquery.Selection) {
+		rel, _ := link.Attr("rel")
+
+		var source pb.IconSource
+		switch {
+		case hasToken(rel, "apple-touch-icon"), hasToken(rel, "apple-touch-icon-precomposed"):
+			source = pb.IconSource_ICON_SOURCE_APPLE_TOUCH_ICON
+		case hasToken(rel, "icon"):
+			source = pb.IconSource_ICON_SOURCE_LINK_ICON
+		default:
+			return
+		}
+
+		href, _ := link.Attr("href")
+		iconURL := resolver.resolve(href)
+		if iconURL == "" {
+			return
+		}
+
+		declaredType, _ := link.Attr("type")
+		sizes, _ := link.Attr("sizes")
+		width, height := largestIconSize(sizes)
+		icons = append(icons, &pb.FeedIcon{
+			Url:      iconURL,
+			MimeType: iconMIMEType(declaredType, iconURL),
+			Width:    width,
+			Height:   height,
+			Source:   source,
+		})
+	})
+
+	// Prefer larger icons; undeclared sizes sort after declared ones, apple-touch-icons (usually 180px) first.
+	sort.SliceStable(icons, func(i, j int) bool {
+		return iconRank(icons[i]) > iconRank(icons[j])
+	})
+	return icons
+}
+
+// iconRank orders page icons by declared size, treating an undeclared apple-touch-icon as 180px.
+func iconRank(icon *pb.FeedIcon) int32 {
+	if icon.Width > 0 {
+		return icon.Width
+	}
+	if icon.Source == pb.IconSource_ICON_SOURCE_APPLE_TOUCH_ICON {
+		return 180
+	}
+	return 0
+}
+
+// largestIconSize parses a sizes attribute such as "16x16 32x32" and returns the largest pair.
+func largestIconSize(sizes string) (int32, int32) {
+	var width, height int32
+	for _, size := range strings.Fields(strings.ToLower(sizes)) {
+		w, h, ok := strings.Cut(size, "x")
+		if !ok {
+			continue
+		}
+		parsedWidth, errW := strconv.ParseInt(w, 10, 32)
+		parsedHeight, errH := strconv.ParseInt(h, 10, 32)
+		if errW != nil || errH != nil {
+			continue
+		}
+		if int32(parsedWidth) > width {
+			width, height = int32(parsedWidth), int32(parsedHeight)
+		}
+	}
+	return width, height
+}
+
+// iconMIMEType returns the declared type, or one guessed from the URL's extension.
+func iconMIMEType(declared, iconURL string) *string {
+	if declared = strings.TrimSpace(declared); declared != "" {
+		return goproto.String(strings.ToLower(declared))
+	}
+
+	parsed, err := neturl.Parse(iconURL)
+	if err != nil {
+		return nil
+	}
+	switch ext := strings.ToLower(path.Ext(parsed.Path)); ext {
+	case ".ico":
+		return goproto.String("image/x-icon")
+	case "":
+		return nil
+	default:
+		return optionalString(mime.TypeByExtension(ext))
+	}
+}
+
+// dedupeIcons drops candidates whose URL was already listed, keeping the first (preferred) occurrence.
+func dedupeIcons(icons []*pb.FeedIcon) []*pb.FeedIcon {
+	seen := make(map[string]bool, len(icons))
+	result := icons[:0]
+	for _, icon := range icons {
+		if seen[icon.Url] {
+			continue
+		}
+		seen[icon.Url] = true
+		result = append(result, icon)
+	}
+	return result
+}
+
+// downloadIcon fetches candidate, rejecting non-image responses and bodies over maxBytes. The returned copy carries
+// the data, its size and, where missing, the MIME type and dimensions.
+func downloadIcon(ctx context.Context, parser *gofeed.Parser, candidate *pb.FeedIcon, maxBytes int64) (*pb.FeedIcon, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, candidate.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if parser.UserAgent != "" {
+		req.Header.Set("User-Agent", parser.UserAgent)
+	}
+
+	client := parser.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, newStatusError(resp)
+	}
+
+	contentType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if contentType != "" && !strings.HasPrefix(contentType, "image/") && contentType != "application/octet-stream" {
+		return nil, fmt.Errorf("icon has content type %q", contentType)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("icon exceeds %d bytes", maxBytes)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("icon is empty")
+	}
+
+	icon := goproto.Clone(candidate).(*pb.FeedIcon)
+	icon.Data = data
+	icon.ByteSize = int64(len(data))
+	if icon.MimeType == nil && strings.HasPrefix(contentType, "image/") {
+		icon.MimeType = goproto.String(contentType)
+	}
+	if icon.Width == 0 {
+		if config, _, err := image.DecodeConfig(bytes.NewReader(data)); err == nil {
+			icon.Width, icon.Height = int32(config.Width), int32(config.Height)
+		}
+	}
+	return icon, nil
+}
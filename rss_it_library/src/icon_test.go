@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	neturl "net/url"
+	"strings"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+// testPNG returns an encoded PNG of the given dimensions.
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatalf("failed to encode PNG: %v", err)
+	}
+	return buffer.Bytes()
+}
+
+func TestLinkedIcons(t *testing.T) {
+	pageURL, _ := neturl.Parse("https://example.com/blog/")
+	page := []byte(`<html><head>
+<link rel="icon" href="/small.png" sizes="16x16 32x32">
+<link rel="apple-touch-icon" href="touch.png">
+<link rel="shortcut icon" href="/favicon.ico">
+<link rel="icon" type="image/svg+xml" href="/large.svg" sizes="192x192">
+<link rel="stylesheet" href="/style.css">
+</head></html>`)
+
+	icons := linkedIcons(page, pageURL)
+
+	expected := []struct {
+		url    string
+		source pb.IconSource
+		width  int32
+	}{
+		{url: "https://example.com/large.svg", source: pb.IconSource_ICON_SOURCE_LINK_ICON, width: 192},
+		{url: "https://example.com/blog/touch.png", source: pb.IconSource_ICON_SOURCE_APPLE_TOUCH_ICON},
+		{url: "https://example.com/small.png", source: pb.IconSource_ICON_SOURCE_LINK_ICON, width: 32},
+		{url: "https://example.com/favicon.ico", source: pb.IconSource_ICON_SOURCE_LINK_ICON},
+	}
+	if len(icons) != len(expected) {
+		t.Fatalf("expected %d icons, got %d: %+v", len(expected), len(icons), icons)
+	}
+	for i, want := range expected {
+		icon := icons[i]
+		if icon.Url != want.url || icon.Source != want.source || icon.Width != want.width {
+			t.Errorf("icon %d: expected %s (%v, %d), got %s (%v, %d)", i, want.url, want.source, want.width, icon.Url, icon.Source, icon.Width)
+		}
+	}
+	if icons[0].GetMimeType() != "image/svg+xml" {
+		t.Errorf("expected declared type, got %q", icons[0].GetMimeType())
+	}
+	if icons[3].GetMimeType() != "image/x-icon" {
+		t.Errorf("expected type guessed from extension, got %q", icons[3].GetMimeType())
+	}
+}
+
+func TestLargestIconSize(t *testing.T) {
+	tests := []struct {
+		sizes  string
+		width  int32
+		height int32
+	}{
+		{sizes: "", width: 0, height: 0},
+		{sizes: "any", width: 0, height: 0},
+		{sizes: "16x16", width: 16, height: 16},
+		{sizes: "16x16 64X64 32x32", width: 64, height: 64},
+		{sizes: "bogus 48x48", width: 48, height: 48},
+	}
+
+	for _, tc := range tests {
+		width, height := largestIconSize(tc.sizes)
+		if width != tc.width || height != tc.height {
+			t.Errorf("largestIconSize(%q) = %dx%d, want %dx%d", tc.sizes, width, height, tc.width, tc.height)
+		}
+	}
+}
+
+func TestIconResolver_ResolveIcon_FeedImage(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/feed.xml", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `<?xml version="1.0"?><rss version="2.0"><channel><title>Icons</title>
+<link>%s/site/</link><image><url>logo.png</url></image></channel></rss>`, server.URL)
+	})
+	mux.HandleFunc("/site/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><head><link rel="icon" href="/site-icon.png"></head></html>`))
+	})
+
+	resolver := NewIconResolver(gofeed.NewParser, defaultIconTimeout)
+	response := resolver.ResolveIcon(context.Background(), &pb.ResolveIconRequest{Url: server.URL + "/feed.xml"})
+	if response.Error != nil {
+		t.Fatalf("unexpected error: %v", response.Error.Message)
+	}
+
+	if response.Icon.GetUrl() != server.URL+"/site/logo.png" || response.Icon.Source != pb.IconSource_ICON_SOURCE_FEED {
+		t.Errorf("expected feed image, got %q from %v", response.Icon.GetUrl(), response.Icon.GetSource())
+	}
+	if len(response.Candidates) != 3 {
+		t.Fatalf("expected feed, link and favicon candidates, got %+v", response.Candidates)
+	}
+	if response.Candidates[1].Url != server.URL+"/site-icon.png" || response.Candidates[2].Source != pb.IconSource_ICON_SOURCE_FAVICON {
+		t.Errorf("unexpected candidates: %+v", response.Candidates)
+	}
+	if response.Icon.Data != nil {
+		t.Error("expected no data without include_data")
+	}
+}
+
+func TestIconResolver_ResolveIcon_FeedLinkingToItself(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/feed.xml", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `<?xml version="1.0"?><rss version="2.0"><channel><title>Self</title><link>%s/feed.xml</link></channel></rss>`, server.URL)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><head><link rel="icon" href="/root-icon.png"></head></html>`))
+	})
+
+	resolver := NewIconResolver(gofeed.NewParser, defaultIconTimeout)
+	response := resolver.ResolveIcon(context.Background(), &pb.ResolveIconRequest{Url: server.URL + "/feed.xml"})
+
+	// A link back to the feed itself says nothing about the site, so the host's root page is used instead.
+	if response.Icon.GetUrl() != server.URL+"/root-icon.png" {
+		t.Errorf("expected the root page's icon, got %q (candidates %+v)", response.Icon.GetUrl(), response.Candidates)
+	}
+}
+
+func TestIconResolver_ResolveIcon_IncludeData(t *testing.T) {
+	large := testPNG(t, 64, 64)
+	small := testPNG(t, 16, 16)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><head>
+<link rel="icon" href="/missing.png" sizes="512x512">
+<link rel="icon" href="/large.png" sizes="64x64">
+</head></html>`))
+	})
+	mux.HandleFunc("/large.png", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(large)
+	})
+	mux.HandleFunc("/favicon.ico", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(small)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resolver := NewIconResolver(gofeed.NewParser, defaultIconTimeout)
+
+	response := resolver.ResolveIcon(context.Background(), &pb.ResolveIconRequest{Url: server.URL + "/", IncludeData: true})
+	if response.Error != nil {
+		t.Fatalf("unexpected error: %v", response.Error.Message)
+	}
+	icon := response.Icon
+	if icon.GetUrl() != server.URL+"/large.png" {
+		t.Fatalf("expected the missing icon to be skipped, got %q", icon.GetUrl())
+	}
+	if !bytes.Equal(icon.Data, large) || icon.ByteSize != int64(len(large)) || icon.GetMimeType() != "image/png" {
+		t.Errorf("unexpected icon payload: %d bytes, %q", icon.ByteSize, icon.GetMimeType())
+	}
+
+	capped := resolver.ResolveIcon(context.Background(), &pb.ResolveIconRequest{
+		Url:         server.URL + "/",
+		IncludeData: true,
+		MaxBytes:    int64(len(small)),
+	})
+	if capped.Icon.GetSource() != pb.IconSource_ICON_SOURCE_FAVICON || capped.Icon.Width != 16 {
+		t.Errorf("expected oversized icon to fall through to favicon.ico, got %q (%dx%d)", capped.Icon.GetUrl(), capped.Icon.GetWidth(), capped.Icon.GetHeight())
+	}
+}
+
+func TestIconResolver_ResolveIcon_NoIcon(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`<html><head><title>Bare</title></head></html>`))
+	}))
+	defer server.Close()
+
+	resolver := NewIconResolver(gofeed.NewParser, defaultIconTimeout)
+	response := resolver.ResolveIcon(context.Background(), &pb.ResolveIconRequest{Url: server.URL})
+	if response.Icon != nil || response.Error == nil {
+		t.Fatalf("expected an error when no icon exists, got %+v", response.Icon)
+	}
+	if len(response.Candidates) != 1 || response.Candidates[0].Source != pb.IconSource_ICON_SOURCE_FAVICON {
+		t.Errorf("expected the favicon guess to be listed, got %+v", response.Candidates)
+	}
+}
+
+func TestIconResolver_ResolveIcon_Validation(t *testing.T) {
+	resolver := NewIconResolver(nil, 0)
+
+	if response := resolver.ResolveIcon(context.Background(), nil); response.Error.GetKind() != pb.ErrorKind_ERROR_KIND_VALIDATION {
+		t.Errorf("expected validation error for nil request, got %v", response.Error)
+	}
+	if response := resolver.ResolveIcon(context.Background(), &pb.ResolveIconRequest{Url: "  "}); response.Error.GetKind() != pb.ErrorKind_ERROR_KIND_VALIDATION {
+		t.Errorf("expected validation error for empty URL, got %v", response.Error)
+	}
+	if response := resolver.ResolveIcon(context.Background(), &pb.ResolveIconRequest{Url: "ftp://example.com/"}); response.Error.GetKind() != pb.ErrorKind_ERROR_KIND_VALIDATION || response.Error.GetRetryable() {
+		t.Errorf("expected non-retryable validation error for an ftp URL, got %v", response.Error)
+	}
+}
+
+func TestIconResolver_ResolveIcon_URLWithoutScheme(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`<html><head><title>Bare</title></head></html>`))
+	}))
+	defer server.Close()
+
+	resolver := NewIconResolver(func() *gofeed.Parser {
+		parser := gofeed.NewParser()
+		parser.Client = server.Client()
+		return parser
+	}, defaultIconTimeout)
+	response := resolver.ResolveIcon(context.Background(), &pb.ResolveIconRequest{Url: strings.TrimPrefix(server.URL, "https://")})
+
+	if len(response.Candidates) != 1 || response.Candidates[0].GetUrl() != server.URL+"/favicon.ico" {
+		t.Errorf("expected the https favicon guess, got %+v (error %v)", response.Candidates, response.Error)
+	}
+}
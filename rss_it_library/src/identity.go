@@ -0,0 +1,63 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+)
+
+const fallbackIDPrefix = "sha256:"
+
+// itemID returns the item's GUID, or a deterministic hash of its link, title and publication date when
+// the feed does not supply one. Fallback IDs carry a "sha256:" prefix so they never collide with real GUIDs.
+func itemID(item *gofeed.Item) string {
+	if guid := strings.TrimSpace(item.GUID); guid != "" {
+		return guid
+	}
+
+	published := strings.TrimSpace(item.Published)
+	if item.PublishedParsed != nil {
+		published = item.PublishedParsed.UTC().Format(time.RFC3339)
+	}
+
+	parts := []string{strings.TrimSpace(item.Link), strings.TrimSpace(item.Title), published}
+	if parts[0] == "" && parts[1] == "" && parts[2] == "" {
+		// Nothing identifying is left; the body is the best remaining discriminator.
+		parts = append(parts, strings.TrimSpace(item.Description), strings.TrimSpace(item.Content))
+	}
+	return fallbackIDPrefix + hashFields(parts...)
+}
+
+// itemContentHash fingerprints the user-visible parts of an item so edits can be detected between fetches.
+func itemContentHash(item *gofeed.Item) string {
+	parts := []string{
+		strings.TrimSpace(item.Title),
+		strings.TrimSpace(item.Description),
+		strings.TrimSpace(item.Content),
+		strings.TrimSpace(item.Link),
+	}
+	if item.Image != nil {
+		parts = append(parts, strings.TrimSpace(item.Image.URL))
+	}
+	for _, enclosure := range item.Enclosures {
+		if enclosure != nil {
+			parts = append(parts, strings.TrimSpace(enclosure.URL))
+		}
+	}
+	return hashFields(parts...)
+}
+
+// hashFields returns the hex SHA-256 digest of fields joined with a separator that cannot appear in text.
+func hashFields(fields ...string) string {
+	digest := sha256.New()
+	for i, field := range fields {
+		if i > 0 {
+			digest.Write([]byte{0})
+		}
+		digest.Write([]byte(field))
+	}
+	return hex.EncodeToString(digest.Sum(nil))
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+)
+
+func TestItemID_UsesGUID(t *testing.T) {
+	item := &gofeed.Item{GUID: "  urn:uuid:1234  ", Title: "Post", Link: "https://example.com/post"}
+
+	if got := itemID(item); got != "urn:uuid:1234" {
+		t.Fatalf("expected trimmed GUID, got %q", got)
+	}
+}
+
+func TestItemID_Fallback(t *testing.T) {
+	published := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	base := func() *gofeed.Item {
+		return &gofeed.Item{
+			Title:           "Post",
+			Link:            "https://example.com/post",
+			PublishedParsed: &published,
+			Description:     "first version",
+		}
+	}
+
+	first := itemID(base())
+	if !strings.HasPrefix(first, fallbackIDPrefix) {
+		t.Fatalf("expected fallback prefix, got %q", first)
+	}
+	if again := itemID(base()); again != first {
+		t.Errorf("expected deterministic fallback ID, got %q and %q", first, again)
+	}
+
+	edited := base()
+	edited.Description = "second version"
+	if got := itemID(edited); got != first {
+		t.Errorf("expected body edits to keep the fallback ID, got %q and %q", first, got)
+	}
+
+	moved := base()
+	moved.Link = "https://example.com/other"
+	if got := itemID(moved); got == first {
+		t.Error("expected a different link to produce a different ID")
+	}
+
+	// The same instant in another zone must hash identically.
+	local := published.In(time.FixedZone("CEST", 2*60*60))
+	shifted := base()
+	shifted.PublishedParsed = &local
+	if got := itemID(shifted); got != first {
+		t.Errorf("expected timezone-independent ID, got %q and %q", first, got)
+	}
+}
+
+func TestItemContentHash(t *testing.T) {
+	item := &gofeed.Item{Title: "Post", Description: "Body", Link: "https://example.com/post"}
+	original := itemContentHash(item)
+
+	if again := itemContentHash(&gofeed.Item{Title: "Post", Description: "Body", Link: "https://example.com/post"}); again != original {
+		t.Errorf("expected stable hash, got %q and %q", original, again)
+	}
+
+	item.Description = "Body (updated)"
+	if updated := itemContentHash(item); updated == original {
+		t.Error("expected edited description to change the content hash")
+	}
+}
+
+func TestHashFieldsSeparatesFields(t *testing.T) {
+	if hashFields("ab", "c") == hashFields("a", "bc") {
+		t.Error("expected field boundaries to affect the hash")
+	}
+}
+
+func TestToProtoFeedItem_Identity(t *testing.T) {
+	withGUID := toProtoFeedItem(&gofeed.Item{GUID: "guid-1", Title: "Post"}, urlResolver{}, conversionOptions{})
+	if withGUID.Id != "guid-1" || withGUID.GetGuid() != "guid-1" {
+		t.Errorf("expected GUID to be used as ID, got id=%q guid=%q", withGUID.Id, withGUID.GetGuid())
+	}
+	if withGUID.ContentHash == "" {
+		t.Error("expected content hash to be populated")
+	}
+
+	withoutGUID := toProtoFeedItem(&gofeed.Item{Title: "Post", Link: "https://example.com/post"}, urlResolver{}, conversionOptions{})
+	if withoutGUID.Guid != nil {
+		t.Errorf("expected guid to be unset, got %q", withoutGUID.GetGuid())
+	}
+	if !strings.HasPrefix(withoutGUID.Id, fallbackIDPrefix) {
+		t.Errorf("expected fallback ID, got %q", withoutGUID.Id)
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	neturl "net/url"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+const testImageFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+  <channel>
+    <title>Image Feed</title>
+    <link>https://example.com/</link>
+    <item>
+      <title>Episode artwork</title>
+      <itunes:image href="https://example.com/episode.jpg"/>
+      <media:thumbnail url="https://example.com/thumb.jpg"/>
+    </item>
+    <item>
+      <title>Media thumbnail</title>
+      <media:content url="https://example.com/video.mp4" type="video/mp4"/>
+      <media:group>
+        <media:thumbnail url="https://example.com/grouped.jpg"/>
+      </media:group>
+    </item>
+    <item>
+      <title>Media content</title>
+      <media:content url="https://example.com/photo.jpg" medium="image"/>
+    </item>
+    <item>
+      <title>Image enclosure</title>
+      <enclosure url="https://example.com/cover.png" type="image/png" length="100"/>
+    </item>
+    <item>
+      <title>Content image</title>
+      <link>https://example.com/posts/5</link>
+      <description><![CDATA[<img src="https://feeds.feedburner.com/~r/example/~4/abc" width="1" height="1"><img src="hero.jpg" width="800">]]></description>
+    </item>
+    <item>
+      <title>Only a pixel</title>
+      <description><![CDATA[<p>Text</p><img src="https://example.com/pixel.gif" width="1" height="1">]]></description>
+    </item>
+  </channel>
+</rss>`
+
+func TestToProtoFeed_ImageFallbackChain(t *testing.T) {
+	parsed, err := gofeed.NewParser().ParseString(testImageFeed)
+	if err != nil {
+		t.Fatalf("failed to parse fixture: %v", err)
+	}
+
+	feed := toProtoFeed("https://example.com/feed.xml", parsed, conversionOptions{})
+
+	expected := []struct {
+		image  string
+		source pb.ImageSource
+	}{
+		{image: "https://example.com/episode.jpg", source: pb.ImageSource_IMAGE_SOURCE_PODCAST},
+		{image: "https://example.com/grouped.jpg", source: pb.ImageSource_IMAGE_SOURCE_MEDIA},
+		{image: "https://example.com/photo.jpg", source: pb.ImageSource_IMAGE_SOURCE_MEDIA},
+		{image: "https://example.com/cover.png", source: pb.ImageSource_IMAGE_SOURCE_ENCLOSURE},
+		{image: "https://example.com/posts/hero.jpg", source: pb.ImageSource_IMAGE_SOURCE_CONTENT},
+		{image: "", source: pb.ImageSource_IMAGE_SOURCE_NONE},
+	}
+
+	for i, want := range expected {
+		item := feed.Items[i]
+		if item.GetImage() != want.image || item.ImageSource != want.source {
+			t.Errorf("item %d (%s): expected %q from %v, got %q from %v", i, item.Title, want.image, want.source, item.GetImage(), item.ImageSource)
+		}
+	}
+}
+
+func TestToProtoFeedItem_ExplicitImage(t *testing.T) {
+	item := &gofeed.Item{Title: "JSON item", Image: &gofeed.Image{URL: "https://example.com/explicit.png"}}
+
+	converted := toProtoFeedItem(item, urlResolver{}, conversionOptions{feedType: "json"})
+	if converted.GetImage() != "https://example.com/explicit.png" || converted.ImageSource != pb.ImageSource_IMAGE_SOURCE_FEED {
+		t.Errorf("expected explicit feed image, got %q from %v", converted.GetImage(), converted.ImageSource)
+	}
+}
+
+func TestOpenGraphImage(t *testing.T) {
+	pageURL, _ := neturl.Parse("https://example.com/posts/1")
+	page := []byte(`<html><head>
+<meta name="twitter:image" content="https://example.com/twitter.jpg">
+<meta property="og:image" content="/og.jpg">
+</head><body></body></html>`)
+
+	if got := openGraphImage(page, pageURL); got != "https://example.com/og.jpg" {
+		t.Errorf("expected resolved og:image, got %q", got)
+	}
+	if got := openGraphImage([]byte(`<html><head></head></html>`), pageURL); got != "" {
+		t.Errorf("expected no image, got %q", got)
+	}
+}
+
+func TestRSSParser_ParseFeeds_OpenGraphImage(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/feed.xml", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `<?xml version="1.0"?><rss version="2.0"><channel><title>OG</title>
+<item><title>Article</title><link>%s/article</link></item></channel></rss>`, server.URL)
+	})
+	mux.HandleFunc("/article", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><head><meta property="og:image" content="/social.png"></head></html>`))
+	})
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+
+	without := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL + "/feed.xml"}})
+	if item := without.Feeds[0].Items[0]; item.Image != nil {
+		t.Errorf("expected no og:image lookup without the flag, got %q", item.GetImage())
+	}
+
+	with := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{
+		Urls:         []string{server.URL + "/feed.xml"},
+		FetchOgImage: true,
+	})
+	item := with.Feeds[0].Items[0]
+	if item.GetImage() != server.URL+"/social.png" || item.ImageSource != pb.ImageSource_IMAGE_SOURCE_OPEN_GRAPH {
+		t.Errorf("expected og:image, got %q from %v", item.GetImage(), item.ImageSource)
+	}
+}
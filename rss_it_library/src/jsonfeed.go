@@ -0,0 +1,186 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/mmcdole/gofeed/json"
+
+	pb "github.com/sunderee/rss-it/proto"
+	goproto "google.golang.org/protobuf/proto"
+)
+
+// jsonFeedTranslator wraps gofeed's JSON Feed translator, remembering the source document so that fields the
+// universal model drops (authors' avatars, banner images, attachments, ...) can still be exported.
+type jsonFeedTranslator struct {
+	gofeed.DefaultJSONTranslator
+	source *json.Feed
+	items  map[*gofeed.Item]*json.Item
+}
+
+// Translate converts a parsed JSON Feed into the universal model and records the originating items.
+func (t *jsonFeedTranslator) Translate(feed interface{}) (*gofeed.Feed, error) {
+	translated, err := t.DefaultJSONTranslator.Translate(feed)
+	if err != nil {
+		return nil, err
+	}
+
+	source, ok := feed.(*json.Feed)
+	if !ok {
+		return translated, nil
+	}
+
+	t.source = source
+	t.items = make(map[*gofeed.Item]*json.Item, len(translated.Items))
+	// The default translator emits exactly one item per source item, in order.
+	for i, item := range translated.Items {
+		if i >= len(source.Items) {
+			break
+		}
+		t.items[item] = source.Items[i]
+		// gofeed reports attachment durations as enclosure lengths; use the declared size instead.
+		for _, enclosure := range item.Enclosures {
+			enclosure.Length = ""
+		}
+		if attachments := source.Items[i].Attachments; attachments != nil {
+			for j, attachment := range *attachments {
+				if j < len(item.Enclosures) && attachment.SizeInBytes > 0 {
+					item.Enclosures[j].Length = strconv.FormatInt(attachment.SizeInBytes, 10)
+				}
+			}
+		}
+	}
+	return translated, nil
+}
+
+// applyJSONFeedDetails copies JSON Feed specific fields onto an already converted feed, resolving URLs with
+// resolver and bounding their text with limits. converted must have been produced by toProtoFeed from parsed so
+// that their items line up.
+func (t *jsonFeedTranslator) applyJSONFeedDetails(converted *pb.Feed, parsed *gofeed.Feed, resolver urlResolver, limits feedLimits) {
+	if t == nil || t.source == nil || converted == nil || parsed == nil {
+		return
+	}
+
+	converted.JsonFeed = toProtoJSONFeedChannel(t.source, resolver)
+	for i, item := range parsed.Items {
+		if i >= len(converted.Items) {
+			break
+		}
+		if source := t.items[item]; source != nil {
+			details := toProtoJSONFeedItem(source, item, resolver)
+			if limits.limitJSONFeedItem(details) {
+				converted.Items[i].Truncated = true
+				converted.Truncated = true
+			}
+			converted.Items[i].JsonFeed = details
+		}
+	}
+}
+
+// toProtoJSONFeedChannel maps the feed-level JSON Feed fields onto a JsonFeedChannel.
+func toProtoJSONFeedChannel(feed *json.Feed, resolver urlResolver) *pb.JsonFeedChannel {
+	authors := feed.Authors
+	if len(authors) == 0 && feed.Author != nil {
+		authors = []*json.Author{feed.Author}
+	}
+
+	return &pb.JsonFeedChannel{
+		HomePageUrl: resolver.resolveOptional(feed.HomePageURL),
+		FeedUrl:     resolver.resolveOptional(feed.FeedURL),
+		Icon:        resolver.resolveOptional(feed.Icon),
+		Favicon:     resolver.resolveOptional(feed.Favicon),
+		NextUrl:     resolver.resolveOptional(feed.NextURL),
+		Language:    optionalString(strings.TrimSpace(feed.Language)),
+		Expired:     feed.Expired,
+		Authors:     toProtoAuthors(authors, resolver),
+	}
+}
+
+// toProtoJSONFeedItem maps the item-level JSON Feed fields onto a JsonFeedItem. translated supplies the
+// modification date already parsed by gofeed and the item link that content_html is sanitized against.
+func toProtoJSONFeedItem(item *json.Item, translated *gofeed.Item, resolver urlResolver) *pb.JsonFeedItem {
+	authors := item.Authors
+	if len(authors) == 0 && item.Author != nil {
+		authors = []*json.Author{item.Author}
+	}
+	contentBase := resolver.base
+	if translated != nil {
+		contentBase = resolver.rebase(translated.Link).base
+	}
+
+	converted := &pb.JsonFeedItem{
+		ContentHtml: optionalString(sanitizeHTML(item.ContentHTML, contentBase)),
+		ContentText: optionalString(strings.TrimSpace(item.ContentText)),
+		Summary:     optionalString(strings.TrimSpace(item.Summary)),
+		BannerImage: resolver.resolveOptional(item.BannerImage),
+		ExternalUrl: resolver.resolveOptional(item.ExternalURL),
+		Authors:     toProtoAuthors(authors, resolver),
+		Tags:        toProtoTags(item.Tags),
+		Language:    optionalString(strings.TrimSpace(item.Language)),
+	}
+	if translated != nil && translated.UpdatedParsed != nil {
+		converted.DateModified = goproto.String(translated.UpdatedParsed.Format(time.RFC3339))
+	}
+	if item.Attachments != nil {
+		converted.Attachments = toProtoAttachments(*item.Attachments, resolver)
+	}
+	return converted
+}
+
+// toProtoAuthors converts JSON Feed authors, skipping entries that carry no information.
+func toProtoAuthors(authors []*json.Author, resolver urlResolver) []*pb.Author {
+	result := make([]*pb.Author, 0, len(authors))
+	for _, author := range authors {
+		if author == nil {
+			continue
+		}
+		converted := &pb.Author{
+			Name:   strings.TrimSpace(author.Name),
+			Url:    resolver.resolveOptional(author.URL),
+			Avatar: resolver.resolveOptional(author.Avatar),
+		}
+		if converted.Name == "" && converted.Url == nil && converted.Avatar == nil {
+			continue
+		}
+		result = append(result, converted)
+	}
+	return result
+}
+
+// toProtoTags trims tags and drops empty ones.
+func toProtoTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// toProtoAttachments converts JSON Feed attachments, skipping entries without a usable URL.
+func toProtoAttachments(attachments []json.Attachments, resolver urlResolver) []*pb.Attachment {
+	result := make([]*pb.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		attachmentURL := resolver.resolve(attachment.URL)
+		if attachmentURL == "" {
+			continue
+		}
+
+		converted := &pb.Attachment{
+			Url:      attachmentURL,
+			MimeType: strings.TrimSpace(attachment.MimeType),
+			Title:    optionalString(strings.TrimSpace(attachment.Title)),
+		}
+		if attachment.SizeInBytes > 0 {
+			converted.SizeInBytes = goproto.Int64(attachment.SizeInBytes)
+		}
+		if attachment.DurationInSeconds > 0 {
+			converted.DurationSeconds = goproto.Int64(attachment.DurationInSeconds)
+		}
+		result = append(result, converted)
+	}
+	return result
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/mmcdole/gofeed/json"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+const testJSONFeed = `{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "JSON Test Feed",
+  "home_page_url": "https://example.com/",
+  "feed_url": "https://example.com/feed.json",
+  "icon": "https://example.com/icon.png",
+  "favicon": "https://example.com/favicon.ico",
+  "next_url": "https://example.com/feed.json?page=2",
+  "language": "en",
+  "expired": true,
+  "authors": [{"name": "Jane Doe", "url": "https://example.com/jane", "avatar": "https://example.com/jane.png"}],
+  "items": [
+    {
+      "id": "1",
+      "url": "https://example.com/posts/1",
+      "external_url": "https://elsewhere.example.org/story",
+      "title": "First",
+      "content_html": "<p>Hello <b>world</b></p>",
+      "content_text": "Hello world",
+      "summary": "A greeting",
+      "banner_image": "https://example.com/banner.png",
+      "date_published": "2024-05-01T10:00:00Z",
+      "date_modified": "2024-05-02T12:30:00+02:00",
+      "tags": ["greeting", " ", "news"],
+      "attachments": [
+        {"url": "https://example.com/ep1.mp3", "mime_type": "audio/mpeg", "title": "Episode 1", "size_in_bytes": 1048576, "duration_in_seconds": 1800}
+      ]
+    },
+    {
+      "id": "2",
+      "content_text": "Just text",
+      "author": {"name": "Legacy Author"}
+    }
+  ]
+}`
+
+func TestRSSParser_ParseFeeds_JSONFeedDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/feed+json")
+		_, _ = w.Write([]byte(testJSONFeed))
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}})
+	if response.Status != pb.ParseFeedsStatus_SUCCESS {
+		t.Fatalf("expected SUCCESS, got %v (errors: %v)", response.Status, response.Errors)
+	}
+
+	feed := response.Feeds[0]
+	channel := feed.GetJsonFeed()
+	if channel == nil {
+		t.Fatal("expected JSON Feed channel details")
+	}
+	if channel.GetFavicon() != "https://example.com/favicon.ico" || channel.GetIcon() != "https://example.com/icon.png" {
+		t.Errorf("unexpected icons: icon=%q favicon=%q", channel.GetIcon(), channel.GetFavicon())
+	}
+	if channel.GetNextUrl() != "https://example.com/feed.json?page=2" || !channel.Expired || channel.GetLanguage() != "en" {
+		t.Errorf("unexpected channel details: %+v", channel)
+	}
+	if len(channel.Authors) != 1 || channel.Authors[0].GetAvatar() != "https://example.com/jane.png" {
+		t.Errorf("expected author with avatar, got %+v", channel.Authors)
+	}
+
+	first := feed.Items[0].GetJsonFeed()
+	if first == nil {
+		t.Fatal("expected JSON Feed item details")
+	}
+	if first.GetContentHtml() != "<p>Hello <b>world</b></p>" || first.GetContentText() != "Hello world" {
+		t.Errorf("unexpected content: html=%q text=%q", first.GetContentHtml(), first.GetContentText())
+	}
+	if first.GetSummary() != "A greeting" || first.GetBannerImage() != "https://example.com/banner.png" {
+		t.Errorf("unexpected summary/banner: %q / %q", first.GetSummary(), first.GetBannerImage())
+	}
+	if first.GetExternalUrl() != "https://elsewhere.example.org/story" {
+		t.Errorf("unexpected external url %q", first.GetExternalUrl())
+	}
+	if first.GetDateModified() != "2024-05-02T12:30:00+02:00" {
+		t.Errorf("unexpected date_modified %q", first.GetDateModified())
+	}
+	if len(first.Tags) != 2 || first.Tags[0] != "greeting" || first.Tags[1] != "news" {
+		t.Errorf("unexpected tags %v", first.Tags)
+	}
+	if len(first.Attachments) != 1 {
+		t.Fatalf("expected 1 attachment, got %d", len(first.Attachments))
+	}
+	attachment := first.Attachments[0]
+	if attachment.GetTitle() != "Episode 1" || attachment.GetSizeInBytes() != 1048576 || attachment.GetDurationSeconds() != 1800 {
+		t.Errorf("unexpected attachment %+v", attachment)
+	}
+
+	// The generic enclosure must report the size, not the duration gofeed puts there.
+	if enclosures := feed.Items[0].Enclosures; len(enclosures) != 1 || enclosures[0].GetLength() != 1048576 {
+		t.Errorf("expected enclosure length from size_in_bytes, got %+v", enclosures)
+	}
+
+	second := feed.Items[1].GetJsonFeed()
+	if second.ContentHtml != nil || second.GetContentText() != "Just text" {
+		t.Errorf("unexpected content for text-only item: %+v", second)
+	}
+	if len(second.Authors) != 1 || second.Authors[0].Name != "Legacy Author" {
+		t.Errorf("expected JSON Feed 1.0 author fallback, got %+v", second.Authors)
+	}
+}
+
+func TestRSSParser_ParseFeeds_RSSHasNoJSONFeedDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}})
+	if response.Status != pb.ParseFeedsStatus_SUCCESS {
+		t.Fatalf("expected SUCCESS, got %v", response.Status)
+	}
+
+	feed := response.Feeds[0]
+	if feed.JsonFeed != nil {
+		t.Errorf("expected no JSON Feed details for RSS, got %+v", feed.JsonFeed)
+	}
+	for _, item := range feed.Items {
+		if item.JsonFeed != nil {
+			t.Errorf("expected no JSON Feed item details for RSS, got %+v", item.JsonFeed)
+		}
+	}
+}
+
+func TestToProtoAuthors_SkipsEmpty(t *testing.T) {
+	authors := toProtoAuthors([]*json.Author{nil, {Name: "  "}, {URL: "https://example.com/me"}}, urlResolver{})
+	if len(authors) != 1 || authors[0].GetUrl() != "https://example.com/me" {
+		t.Errorf("expected only the author with a URL, got %v", authors)
+	}
+}
+
+func TestRSSParser_ParseFeeds_JSONFeedContentHTMLSanitized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/feed+json")
+		_, _ = w.Write([]byte(`{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "Hostile",
+  "items": [{
+    "id": "1",
+    "url": "https://example.com/posts/1/",
+    "content_html": "<p onclick=\"evil()\">hi <img src=\"cover.png\"></p><script>alert(1)</script>"
+  }]
+}`))
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}})
+	if len(response.Feeds) != 1 {
+		t.Fatalf("expected one feed, got errors %v", response.Errors)
+	}
+
+	html := response.Feeds[0].Items[0].GetJsonFeed().GetContentHtml()
+	if strings.Contains(html, "script") || strings.Contains(html, "onclick") {
+		t.Errorf("expected content_html to be sanitized, got %q", html)
+	}
+	if !strings.Contains(html, `src="https://example.com/posts/1/cover.png"`) {
+		t.Errorf("expected relative URLs resolved against the item link, got %q", html)
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+const (
+	defaultMaxBodyBytes         = 10 << 20
+	maxBodyBytesLimit           = 64 << 20
+	defaultMaxItems             = 1000
+	defaultMaxTitleLength       = 1000
+	defaultMaxDescriptionLength = 50_000
+	defaultMaxContentLength     = 500_000
+)
+
+// errBodyTooLarge reports a response body larger than the configured limit.
+var errBodyTooLarge = errors.New("response body too large")
+
+// feedLimits bounds how much of a hostile or misconfigured feed is downloaded and converted. Lengths are in runes;
+// zero values (as in an empty conversionOptions) mean unlimited.
+type feedLimits struct {
+	maxBodyBytes         int64
+	maxItems             int
+	maxTitleLength       int
+	maxDescriptionLength int
+	maxContentLength     int
+}
+
+// newFeedLimits converts the request's limits, using defaults for unset values and capping the body size.
+func newFeedLimits(config *pb.FeedLimits) feedLimits {
+	limits := feedLimits{
+		maxBodyBytes:         config.GetMaxBodyBytes(),
+		maxItems:             int(config.GetMaxItems()),
+		maxTitleLength:       int(config.GetMaxTitleLength()),
+		maxDescriptionLength: int(config.GetMaxDescriptionLength()),
+		maxContentLength:     int(config.GetMaxContentLength()),
+	}
+	if limits.maxBodyBytes <= 0 {
+		limits.maxBodyBytes = defaultMaxBodyBytes
+	}
+	limits.maxBodyBytes = min(limits.maxBodyBytes, maxBodyBytesLimit)
+	if limits.maxItems <= 0 {
+		limits.maxItems = defaultMaxItems
+	}
+	if limits.maxTitleLength <= 0 {
+		limits.maxTitleLength = defaultMaxTitleLength
+	}
+	if limits.maxDescriptionLength <= 0 {
+		limits.maxDescriptionLength = defaultMaxDescriptionLength
+	}
+	if limits.maxContentLength <= 0 {
+		limits.maxContentLength = defaultMaxContentLength
+	}
+	return limits
+}
+
+// readBody reads a response body of at most limit bytes. The limit applies to the decoded body, so a small
+// gzip-compressed response that expands beyond it is rejected as well.
+func readBody(resp *http.Response, limit int64) ([]byte, error) {
+	if resp.ContentLength > limit {
+		return nil, fmt.Errorf("%w: declared %d bytes, limit is %d", errBodyTooLarge, resp.ContentLength, limit)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > limit {
+		return nil, fmt.Errorf("%w: exceeds limit of %d bytes", errBodyTooLarge, limit)
+	}
+	return body, nil
+}
+
+// limitFeed truncates the feed's own text fields, marking the feed as truncated if anything was cut.
+func (l feedLimits) limitFeed(feed *pb.Feed) {
+	var titleCut, descriptionCut bool
+	feed.Title, titleCut = limitText(feed.Title, l.maxTitleLength)
+	if feed.Description != nil {
+		*feed.Description, descriptionCut = limitText(*feed.Description, l.maxDescriptionLength)
+	}
+	feed.Truncated = feed.Truncated || titleCut || descriptionCut
+}
+
+// limitItem truncates an item's title, description and content according to the format each is rendered in,
+// marking the item as truncated if anything was cut.
+func (l feedLimits) limitItem(item *pb.FeedItem, format pb.ContentFormat) {
+	var titleCut, descriptionCut, contentCut bool
+	item.Title, titleCut = limitText(item.Title, l.maxTitleLength)
+	if item.Description != nil {
+		if format == pb.ContentFormat_CONTENT_FORMAT_HTML {
+			*item.Description, descriptionCut = limitHTML(*item.Description, l.maxDescriptionLength)
+		} else {
+			*item.Description, descriptionCut = limitText(*item.Description, l.maxDescriptionLength)
+		}
+	}
+	if item.Content != nil {
+		if format == pb.ContentFormat_CONTENT_FORMAT_PLAIN_TEXT {
+			*item.Content, contentCut = limitText(*item.Content, l.maxContentLength)
+		} else {
+			*item.Content, contentCut = limitHTML(*item.Content, l.maxContentLength)
+		}
+	}
+	item.Truncated = titleCut || descriptionCut || contentCut
+}
+
+// limitJSONFeedItem truncates the JSON Feed copies of an item's content and summary like limitItem does, reporting
+// whether anything was cut.
+func (l feedLimits) limitJSONFeedItem(item *pb.JsonFeedItem) bool {
+	var htmlCut, textCut, summaryCut bool
+	if item.ContentHtml != nil {
+		*item.ContentHtml, htmlCut = limitHTML(*item.ContentHtml, l.maxContentLength)
+	}
+	if item.ContentText != nil {
+		*item.ContentText, textCut = limitText(*item.ContentText, l.maxContentLength)
+	}
+	if item.Summary != nil {
+		*item.Summary, summaryCut = limitText(*item.Summary, l.maxDescriptionLength)
+	}
+	return htmlCut || textCut || summaryCut
+}
+
+// limitText shortens plain text to at most limit runes, reporting whether it was cut.
+func limitText(text string, limit int) (string, bool) {
+	if limit <= 0 || utf8.RuneCountInString(text) <= limit {
+		return text, false
+	}
+	return truncateText(text, limit-1), true
+}
+
+// limitHTML shortens an HTML fragment to roughly limit runes of markup, reporting whether it was cut. The cut
+// fragment is sanitized again so that elements left open are closed.
+func limitHTML(fragment string, limit int) (string, bool) {
+	if limit <= 0 || utf8.RuneCountInString(fragment) <= limit {
+		return fragment, false
+	}
+
+	cut := string([]rune(fragment)[:limit])
+	if open := strings.LastIndexByte(cut, '<'); open > strings.LastIndexByte(cut, '>') {
+		// Drop a tag split in half rather than leaving its remains as text.
+		cut = cut[:open]
+	}
+	return sanitizeHTML(cut, nil), true
+}
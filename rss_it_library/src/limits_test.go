@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+// testLargeFeed builds an RSS feed with the given number of items, each carrying a long title and description.
+func testLargeFeed(items int) string {
+	var builder strings.Builder
+	builder.WriteString(`<?xml version="1.0"?><rss version="2.0"><channel><title>Large Feed</title>`)
+	for i := range items {
+		fmt.Fprintf(&builder, `<item><title>Item %d %s</title><description><![CDATA[<p>%s</p><p>Tail</p>]]></description></item>`,
+			i, strings.Repeat("word ", 40), strings.Repeat("body ", 200))
+	}
+	builder.WriteString(`</channel></rss>`)
+	return builder.String()
+}
+
+func TestNewFeedLimits(t *testing.T) {
+	defaults := newFeedLimits(nil)
+	if defaults.maxBodyBytes != defaultMaxBodyBytes || defaults.maxItems != defaultMaxItems || defaults.maxContentLength != defaultMaxContentLength {
+		t.Errorf("unexpected defaults: %+v", defaults)
+	}
+
+	capped := newFeedLimits(&pb.FeedLimits{MaxBodyBytes: 1 << 40, MaxItems: 5})
+	if capped.maxBodyBytes != maxBodyBytesLimit || capped.maxItems != 5 {
+		t.Errorf("expected capped body size and custom item limit, got %+v", capped)
+	}
+}
+
+func TestFetchFeed_BodyTooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testLargeFeed(20)))
+	}))
+	defer server.Close()
+
+	_, err := fetchFeed(context.Background(), gofeed.NewParser(), server.URL, nil, 1024)
+	if !errors.Is(err, errBodyTooLarge) {
+		t.Fatalf("expected errBodyTooLarge, got %v", err)
+	}
+	if detail := newFetchErrorDetail(err, server.URL); detail.Kind != pb.ErrorKind_ERROR_KIND_BODY_TOO_LARGE || detail.Retryable {
+		t.Errorf("expected non-retryable BODY_TOO_LARGE, got %v (retryable %v)", detail.Kind, detail.Retryable)
+	}
+}
+
+func TestFetchFeed_DecompressionBomb(t *testing.T) {
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, _ = writer.Write([]byte(testRSSFeed[:len(testRSSFeed)-len("</rss>")]))
+	_, _ = writer.Write(bytes.Repeat([]byte(" "), 4<<20))
+	_ = writer.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(compressed.Bytes())
+	}))
+	defer server.Close()
+
+	if compressed.Len() > 64<<10 {
+		t.Fatalf("fixture should compress well, got %d bytes", compressed.Len())
+	}
+
+	_, err := fetchFeed(context.Background(), gofeed.NewParser(), server.URL, nil, 1<<20)
+	if !errors.Is(err, errBodyTooLarge) {
+		t.Fatalf("expected the decoded size to be limited, got %v", err)
+	}
+}
+
+func TestRSSParser_ParseFeeds_Limits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testLargeFeed(10)))
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+
+	unlimited := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL}})
+	if feed := unlimited.Feeds[0]; feed.Truncated || len(feed.Items) != 10 || feed.Items[0].Truncated {
+		t.Fatalf("expected the default limits to leave the feed intact, got %d items (truncated %v)", len(feed.Items), feed.Truncated)
+	}
+
+	limited := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{
+		Urls: []string{server.URL},
+		Limits: &pb.FeedLimits{
+			MaxItems:             3,
+			MaxTitleLength:       20,
+			MaxDescriptionLength: 50,
+			MaxContentLength:     100,
+		},
+	})
+	feed := limited.Feeds[0]
+	if !feed.Truncated || len(feed.Items) != 3 {
+		t.Fatalf("expected 3 items and a truncated feed, got %d (truncated %v)", len(feed.Items), feed.Truncated)
+	}
+
+	item := feed.Items[0]
+	if !item.Truncated {
+		t.Error("expected the item to be marked truncated")
+	}
+	if runes := len([]rune(item.Title)); runes > 20 || !strings.HasSuffix(item.Title, "…") {
+		t.Errorf("expected title cut to 20 runes with an ellipsis, got %q", item.Title)
+	}
+	if runes := len([]rune(item.GetDescription())); runes > 50 {
+		t.Errorf("expected description cut to 50 runes, got %d", runes)
+	}
+	if content := item.GetContent(); !strings.HasPrefix(content, "<p>body") || !strings.HasSuffix(content, "</p>") || strings.Contains(content, "Tail") {
+		t.Errorf("expected content cut with its paragraph closed, got %q", content)
+	}
+}
+
+func TestRSSParser_ParseFeeds_LimitsJSONFeed(t *testing.T) {
+	body := strings.Repeat("body ", 1000)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/feed+json")
+		fmt.Fprintf(w, `{"version": "https://jsonfeed.org/version/1.1", "title": "JSON Feed", "items": [
+			{"id": "1", "content_html": "<p>%s</p>", "content_text": "%s", "summary": "%s"}
+		]}`, body, body, body)
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{
+		Urls:   []string{server.URL},
+		Limits: &pb.FeedLimits{MaxDescriptionLength: 100, MaxContentLength: 100},
+	})
+	if len(response.Feeds) != 1 || len(response.Feeds[0].Items) != 1 {
+		t.Fatalf("expected one feed with one item, got %v", response.Errors)
+	}
+
+	feed := response.Feeds[0]
+	item := feed.Items[0]
+	if !feed.Truncated || !item.Truncated {
+		t.Error("expected the feed and item to be marked truncated")
+	}
+	details := item.GetJsonFeed()
+	if html := details.GetContentHtml(); !strings.HasPrefix(html, "<p>body") || !strings.HasSuffix(html, "</p>") || len(html) > 110 {
+		t.Errorf("expected content_html cut with its paragraph closed, got %q", html)
+	}
+	for name, value := range map[string]string{"content_text": details.GetContentText(), "summary": details.GetSummary()} {
+		if runes := len([]rune(value)); value == "" || runes > 100 {
+			t.Errorf("expected %s cut to 100 runes, got %d", name, runes)
+		}
+	}
+}
+
+func TestLimitHTML(t *testing.T) {
+	if got, cut := limitHTML("<p>short</p>", 100); got != "<p>short</p>" || cut {
+		t.Errorf("expected short fragment untouched, got %q (cut %v)", got, cut)
+	}
+	if got, cut := limitHTML(`<p>one two</p><a href="https://example.com/">link</a>`, 20); got != "<p>one two</p>" || !cut {
+		t.Errorf("expected split tag dropped, got %q (cut %v)", got, cut)
+	}
+}
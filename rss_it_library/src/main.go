@@ -5,6 +5,12 @@ package main
 #include <stdbool.h>
 #include <string.h>
 #include <stdint.h>
+
+typedef void (*parse_event_callback)(char* event);
+
+static inline void invoke_parse_event_callback(parse_event_callback callback, char* event) {
+	callback(event);
+}
 */
 import "C"
 import (
@@ -14,7 +20,6 @@ import (
 	"time"
 	"unsafe"
 
-	"github.com/mmcdole/gofeed"
 	pb "github.com/sunderee/rss-it/proto"
 	goproto "google.golang.org/protobuf/proto"
 )
@@ -24,11 +29,62 @@ const (
 )
 
 var (
-	parserFactory   = gofeed.NewParser
-	sharedValidator = NewRSSValidator(parserFactory, defaultValidationTimeout)
-	sharedParser    = NewRSSParser(parserFactory, defaultParserConcurrency)
+	sharedClient       = NewHTTPClientProvider()
+	parserFactory      = sharedClient.NewParser
+	sharedValidator    = NewRSSValidator(parserFactory, defaultValidationTimeout)
+	sharedCache        = NewFeedCache(defaultCacheTTL, defaultCacheMaxBytes)
+	sharedParser       = NewRSSParser(parserFactory, defaultParserConcurrency, sharedCache)
+	sharedDiscoverer   = NewFeedDiscoverer(parserFactory, defaultDiscoveryTimeout)
+	sharedIconResolver = NewIconResolver(parserFactory, defaultIconTimeout)
+	sharedImporter     = NewOPMLImporter(sharedValidator, defaultOPMLValidationConcurrency)
+	sharedRequests     = NewRequestRegistry()
 )
 
+//export configure
+func configure(data *C.char, length C.int) *C.char {
+	bytes := C.GoBytes(unsafe.Pointer(data), length)
+
+	config := &pb.ClientConfig{}
+	if err := goproto.Unmarshal(bytes, config); err != nil {
+		response := &pb.ConfigureResponse{
+			Success: false,
+			Error:   newErrorDetail(pb.ErrorKind_ERROR_KIND_SERIALIZATION, fmt.Sprintf("decode client config: %v", err), ""),
+		}
+		return marshalToC(response, func(mErr error) goproto.Message {
+			return &pb.ConfigureResponse{
+				Success: false,
+				Error:   newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode configure response: %v", mErr), ""),
+			}
+		})
+	}
+
+	response := &pb.ConfigureResponse{Success: true}
+	if err := applyClientConfig(sharedClient, sharedCache, config); err != nil {
+		response.Success = false
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, err.Error(), "")
+	}
+
+	return marshalToC(response, func(mErr error) goproto.Message {
+		return &pb.ConfigureResponse{
+			Success: false,
+			Error:   newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode configure response: %v", mErr), ""),
+		}
+	})
+}
+
+// applyClientConfig hands config to the HTTP client provider and the feed cache. The cache limits are checked before
+// the provider swaps its client, which it only does once its own settings are valid, so an invalid config leaves both
+// untouched.
+func applyClientConfig(provider *HTTPClientProvider, cache *FeedCache, config *pb.ClientConfig) error {
+	if err := validateCacheLimits(config); err != nil {
+		return err
+	}
+	if err := provider.Configure(config); err != nil {
+		return err
+	}
+	return cache.Configure(config)
+}
+
 //export validate
 func validate(data *C.char, length C.int) *C.char {
 	bytes := C.GoBytes(unsafe.Pointer(data), length)
@@ -47,7 +103,9 @@ func validate(data *C.char, length C.int) *C.char {
 		})
 	}
 
-	ctx := context.Background()
+	ctx, release := sharedRequests.Track(context.Background(), request.GetRequestId())
+	defer release()
+
 	response := sharedValidator.ValidateFeedURL(ctx, request)
 
 	return marshalToC(response, func(mErr error) goproto.Message {
@@ -76,9 +134,12 @@ func parse(data *C.char, length C.int) *C.char {
 		})
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), defaultParseTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), durationOrDefault(request.GetTimeoutMs(), defaultParseTimeout))
 	defer cancel()
 
+	ctx, release := sharedRequests.Track(ctx, request.GetRequestId())
+	defer release()
+
 	response := sharedParser.ParseFeeds(ctx, request)
 
 	return marshalToC(response, func(mErr error) goproto.Message {
@@ -89,6 +150,219 @@ func parse(data *C.char, length C.int) *C.char {
 	})
 }
 
+//export discover
+func discover(data *C.char, length C.int) *C.char {
+	bytes := C.GoBytes(unsafe.Pointer(data), length)
+
+	request := &pb.DiscoverFeedsRequest{}
+	if err := goproto.Unmarshal(bytes, request); err != nil {
+		response := &pb.DiscoverFeedsResponse{
+			Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_SERIALIZATION, fmt.Sprintf("decode discover request: %v", err), ""),
+		}
+		return marshalToC(response, func(mErr error) goproto.Message {
+			return &pb.DiscoverFeedsResponse{
+				Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode discover response: %v", mErr), ""),
+			}
+		})
+	}
+
+	ctx, release := sharedRequests.Track(context.Background(), request.GetRequestId())
+	defer release()
+
+	response := sharedDiscoverer.DiscoverFeeds(ctx, request)
+
+	return marshalToC(response, func(mErr error) goproto.Message {
+		return &pb.DiscoverFeedsResponse{
+			Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode discover response: %v", mErr), request.GetUrl()),
+		}
+	})
+}
+
+//export resolve_icon
+func resolve_icon(data *C.char, length C.int) *C.char {
+	bytes := C.GoBytes(unsafe.Pointer(data), length)
+
+	request := &pb.ResolveIconRequest{}
+	if err := goproto.Unmarshal(bytes, request); err != nil {
+		response := &pb.ResolveIconResponse{
+			Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_SERIALIZATION, fmt.Sprintf("decode resolve icon request: %v", err), ""),
+		}
+		return marshalToC(response, func(mErr error) goproto.Message {
+			return &pb.ResolveIconResponse{
+				Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode resolve icon response: %v", mErr), ""),
+			}
+		})
+	}
+
+	ctx, release := sharedRequests.Track(context.Background(), request.GetRequestId())
+	defer release()
+
+	response := sharedIconResolver.ResolveIcon(ctx, request)
+
+	return marshalToC(response, func(mErr error) goproto.Message {
+		return &pb.ResolveIconResponse{
+			Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode resolve icon response: %v", mErr), request.GetUrl()),
+		}
+	})
+}
+
+//export import_opml
+func import_opml(data *C.char, length C.int) *C.char {
+	bytes := C.GoBytes(unsafe.Pointer(data), length)
+
+	request := &pb.ImportOpmlRequest{}
+	if err := goproto.Unmarshal(bytes, request); err != nil {
+		response := &pb.ImportOpmlResponse{
+			Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_SERIALIZATION, fmt.Sprintf("decode import OPML request: %v", err), ""),
+		}
+		return marshalToC(response, func(mErr error) goproto.Message {
+			return &pb.ImportOpmlResponse{
+				Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode import OPML response: %v", mErr), ""),
+			}
+		})
+	}
+
+	ctx, release := sharedRequests.Track(context.Background(), request.GetRequestId())
+	defer release()
+
+	response := sharedImporter.ImportOPML(ctx, request)
+
+	return marshalToC(response, func(mErr error) goproto.Message {
+		return &pb.ImportOpmlResponse{
+			Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode import OPML response: %v", mErr), ""),
+		}
+	})
+}
+
+//export export_opml
+func export_opml(data *C.char, length C.int) *C.char {
+	bytes := C.GoBytes(unsafe.Pointer(data), length)
+
+	request := &pb.ExportOpmlRequest{}
+	if err := goproto.Unmarshal(bytes, request); err != nil {
+		response := &pb.ExportOpmlResponse{
+			Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_SERIALIZATION, fmt.Sprintf("decode export OPML request: %v", err), ""),
+		}
+		return marshalToC(response, func(mErr error) goproto.Message {
+			return &pb.ExportOpmlResponse{
+				Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode export OPML response: %v", mErr), ""),
+			}
+		})
+	}
+
+	response := ExportOPML(request)
+
+	return marshalToC(response, func(mErr error) goproto.Message {
+		return &pb.ExportOpmlResponse{
+			Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode export OPML response: %v", mErr), ""),
+		}
+	})
+}
+
+// parse_stream parses in the background and returns immediately, posting each feed, per-feed error and the final
+// summary to callback as a length-prefixed ParseStreamEvent that the receiver releases with free_result.
+//
+//export parse_stream
+func parse_stream(data *C.char, length C.int, callback C.parse_event_callback) {
+	if callback == nil {
+		return
+	}
+
+	bytes := C.GoBytes(unsafe.Pointer(data), length)
+
+	post := func(event *pb.ParseStreamEvent) {
+		payload := marshalToC(event, func(mErr error) goproto.Message {
+			return &pb.ParseStreamEvent{Event: &pb.ParseStreamEvent_Error{
+				Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode parse stream event: %v", mErr), ""),
+			}}
+		})
+		C.invoke_parse_event_callback(callback, payload)
+	}
+
+	request := &pb.ParseFeedsRequest{}
+	if err := goproto.Unmarshal(bytes, request); err != nil {
+		post(&pb.ParseStreamEvent{Event: &pb.ParseStreamEvent_Summary{Summary: &pb.ParseStreamSummary{
+			Status:     pb.ParseFeedsStatus_ERROR,
+			FatalError: newErrorDetail(pb.ErrorKind_ERROR_KIND_SERIALIZATION, fmt.Sprintf("decode parse request: %v", err), ""),
+		}}})
+		return
+	}
+
+	// Register before returning so a cancel issued right after parse_stream cannot be missed.
+	ctx, cancelTimeout := context.WithTimeout(context.Background(), durationOrDefault(request.GetTimeoutMs(), defaultParseTimeout))
+	ctx, release := sharedRequests.Track(ctx, request.GetRequestId())
+
+	go func() {
+		defer cancelTimeout()
+		defer release()
+
+		sharedParser.StreamFeeds(ctx, request, post)
+	}()
+}
+
+//export normalize_urls
+func normalize_urls(data *C.char, length C.int) *C.char {
+	bytes := C.GoBytes(unsafe.Pointer(data), length)
+
+	request := &pb.NormalizeUrlsRequest{}
+	if err := goproto.Unmarshal(bytes, request); err != nil {
+		response := &pb.NormalizeUrlsResponse{
+			Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_SERIALIZATION, fmt.Sprintf("decode normalize request: %v", err), ""),
+		}
+		return marshalToC(response, func(mErr error) goproto.Message {
+			return &pb.NormalizeUrlsResponse{
+				Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode normalize response: %v", mErr), ""),
+			}
+		})
+	}
+
+	response := NormalizeURLs(request)
+
+	return marshalToC(response, func(mErr error) goproto.Message {
+		return &pb.NormalizeUrlsResponse{
+			Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode normalize response: %v", mErr), ""),
+		}
+	})
+}
+
+//export cache
+func cache(data *C.char, length C.int) *C.char {
+	bytes := C.GoBytes(unsafe.Pointer(data), length)
+
+	request := &pb.CacheRequest{}
+	if err := goproto.Unmarshal(bytes, request); err != nil {
+		response := &pb.CacheResponse{
+			Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_SERIALIZATION, fmt.Sprintf("decode cache request: %v", err), ""),
+		}
+		return marshalToC(response, func(mErr error) goproto.Message {
+			return &pb.CacheResponse{
+				Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode cache response: %v", mErr), ""),
+			}
+		})
+	}
+
+	response := sharedCache.ManageCache(request)
+
+	return marshalToC(response, func(mErr error) goproto.Message {
+		return &pb.CacheResponse{
+			Error: newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, fmt.Sprintf("encode cache response: %v", mErr), ""),
+		}
+	})
+}
+
+//export cancel
+func cancel(data *C.char, length C.int) *C.char {
+	bytes := C.GoBytes(unsafe.Pointer(data), length)
+
+	request := &pb.CancelRequest{}
+	if err := goproto.Unmarshal(bytes, request); err != nil {
+		return marshalToC(&pb.CancelResponse{Cancelled: false}, nil)
+	}
+
+	response := &pb.CancelResponse{Cancelled: sharedRequests.Cancel(request.GetRequestId())}
+	return marshalToC(response, nil)
+}
+
 //export free_result
 func free_result(ptr *C.char) {
 	if ptr == nil {
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	neturl "net/url"
+	"strconv"
+	"strings"
+	"syscall"
+
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+// errBlockedByPolicy reports a request refused by the network policy.
+var errBlockedByPolicy = errors.New("blocked by network policy")
+
+// defaultAllowedPorts are the ports reachable under a network policy that lists none.
+var defaultAllowedPorts = []int{80, 443}
+
+// reservedPrefixes are special-purpose ranges that netip does not classify as private, loopback or link-local but
+// that must not be reachable either: "this network", carrier-grade NAT, IETF protocol assignments, benchmarking and
+// the reserved class E space including broadcast.
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+}
+
+// nat64Prefix and sixToFourPrefix translate to IPv4 addresses, which may be private.
+var (
+	nat64Prefix     = netip.MustParsePrefix("64:ff9b::/96")
+	sixToFourPrefix = netip.MustParsePrefix("2002::/16")
+)
+
+// networkPolicy restricts outgoing requests to public http(s) hosts on allowed ports, protecting server-side
+// deployments from SSRF. Addresses are checked when connecting, after DNS resolution, so redirects and DNS
+// rebinding cannot reach internal hosts either.
+type networkPolicy struct {
+	allowedPorts map[int]bool
+	// proxyAddress is the configured proxy, which is dialed even when it lives on a private network.
+	proxyAddress string
+}
+
+// newNetworkPolicy builds the policy described by config, or returns nil when it is not enabled. proxyURL is the
+// explicitly configured proxy, if any. A proxy resolves hostnames itself, so behind one only literal addresses
+// can be checked.
+func newNetworkPolicy(config *pb.NetworkPolicy, proxyURL *neturl.URL) (*networkPolicy, error) {
+	if !config.GetEnabled() {
+		return nil, nil
+	}
+
+	policy := &networkPolicy{allowedPorts: make(map[int]bool)}
+	for _, port := range config.GetAllowedPorts() {
+		if port <= 0 || port > 65535 {
+			return nil, fmt.Errorf("invalid allowed port %d", port)
+		}
+		policy.allowedPorts[int(port)] = true
+	}
+	if len(policy.allowedPorts) == 0 {
+		for _, port := range defaultAllowedPorts {
+			policy.allowedPorts[port] = true
+		}
+	}
+
+	if proxyURL != nil {
+		port := proxyURL.Port()
+		if port == "" {
+			port = map[string]string{"http": "80", "https": "443", "socks5": "1080"}[proxyURL.Scheme]
+		}
+		policy.proxyAddress = net.JoinHostPort(proxyURL.Hostname(), port)
+	}
+	return policy, nil
+}
+
+// checkURL rejects URLs with a scheme other than http(s), a port that is not allowed, a literal blocked IP or a
+// localhost name. Behind a proxy these are the only checks made, as the proxy resolves hostnames itself.
+func (p *networkPolicy) checkURL(target *neturl.URL) error {
+	if target.Scheme != "http" && target.Scheme != "https" {
+		return fmt.Errorf("%w: scheme %q is not allowed", errBlockedByPolicy, target.Scheme)
+	}
+
+	port := 80
+	if target.Scheme == "https" {
+		port = 443
+	}
+	if rawPort := target.Port(); rawPort != "" {
+		parsed, err := strconv.Atoi(rawPort)
+		if err != nil {
+			return fmt.Errorf("%w: invalid port %q", errBlockedByPolicy, rawPort)
+		}
+		port = parsed
+	}
+	if !p.allowedPorts[port] {
+		return fmt.Errorf("%w: port %d is not allowed", errBlockedByPolicy, port)
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(target.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: %s is a loopback name", errBlockedByPolicy, host)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && blockedAddress(addr) {
+		return fmt.Errorf("%w: %s is not a public address", errBlockedByPolicy, addr)
+	}
+	return nil
+}
+
+// control is a net.Dialer Control hook refusing connections to blocked addresses once the host has been resolved.
+func (p *networkPolicy) control(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: cannot check address %q", errBlockedByPolicy, address)
+	}
+	if blockedAddress(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s is not a public address", errBlockedByPolicy, addrPort.Addr())
+	}
+	return nil
+}
+
+// dialContext returns a dial function that enforces the policy on every connection except those to the proxy.
+func (p *networkPolicy) dialContext(dialer *net.Dialer) func(ctx context.Context, network, address string) (net.Conn, error) {
+	guarded := *dialer
+	guarded.Control = p.control
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		if p.proxyAddress != "" && address == p.proxyAddress {
+			return dialer.DialContext(ctx, network, address)
+		}
+		return guarded.DialContext(ctx, network, address)
+	}
+}
+
+// blockedAddress reports whether addr is loopback, private, link-local, multicast, unspecified or reserved. NAT64
+// and 6to4 addresses are judged by the IPv4 address they embed.
+func blockedAddress(addr netip.Addr) bool {
+	addr = embeddedIPv4(addr.Unmap())
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return true
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// embeddedIPv4 returns the IPv4 address carried by a NAT64 (64:ff9b::/96) or 6to4 (2002::/16) address, or addr
+// itself.
+func embeddedIPv4(addr netip.Addr) netip.Addr {
+	bytes := addr.As16()
+	switch {
+	case nat64Prefix.Contains(addr):
+		return netip.AddrFrom4([4]byte(bytes[12:16]))
+	case sixToFourPrefix.Contains(addr):
+		return netip.AddrFrom4([4]byte(bytes[2:6]))
+	default:
+		return addr
+	}
+}
+
+// policyTransport checks every request, including each redirect hop, against the network policy before handing
+// it to the next transport.
+type policyTransport struct {
+	next   http.RoundTripper
+	policy *networkPolicy
+}
+
+// RoundTrip refuses requests the policy does not allow.
+func (t *policyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.policy.checkURL(req.URL); err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
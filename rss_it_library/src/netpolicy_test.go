@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	neturl "net/url"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+func TestBlockedAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		blocked bool
+	}{
+		{address: "127.0.0.1", blocked: true},
+		{address: "10.1.2.3", blocked: true},
+		{address: "172.16.0.1", blocked: true},
+		{address: "192.168.1.1", blocked: true},
+		{address: "169.254.169.254", blocked: true},
+		{address: "100.64.0.1", blocked: true},
+		{address: "0.0.0.0", blocked: true},
+		{address: "255.255.255.255", blocked: true},
+		{address: "224.0.0.1", blocked: true},
+		{address: "::1", blocked: true},
+		{address: "fe80::1", blocked: true},
+		{address: "fd00::1", blocked: true},
+		{address: "::ffff:127.0.0.1", blocked: true},
+		{address: "64:ff9b::7f00:1", blocked: true},
+		{address: "64:ff9b::a00:1", blocked: true},
+		{address: "2002:c0a8:101::1", blocked: true},
+		{address: "2002:7f00:1::1", blocked: true},
+		{address: "64:ff9b::5db8:d822", blocked: false},
+		{address: "2002:5db8:d822::1", blocked: false},
+		{address: "93.184.216.34", blocked: false},
+		{address: "2606:2800:220:1:248:1893:25c8:1946", blocked: false},
+	}
+
+	for _, tc := range tests {
+		if got := blockedAddress(netip.MustParseAddr(tc.address)); got != tc.blocked {
+			t.Errorf("blockedAddress(%s) = %v, want %v", tc.address, got, tc.blocked)
+		}
+	}
+}
+
+func TestNetworkPolicy_CheckURL(t *testing.T) {
+	policy, err := newNetworkPolicy(&pb.NetworkPolicy{Enabled: true}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		url     string
+		allowed bool
+	}{
+		{url: "https://example.com/feed.xml", allowed: true},
+		{url: "http://example.com:80/feed.xml", allowed: true},
+		{url: "https://example.com:8443/feed.xml", allowed: false},
+		{url: "file:///etc/passwd", allowed: false},
+		{url: "ftp://example.com/feed.xml", allowed: false},
+		{url: "http://127.0.0.1/feed.xml", allowed: false},
+		{url: "http://[::1]/feed.xml", allowed: false},
+		{url: "http://localhost/feed.xml", allowed: false},
+		{url: "http://LocalHost./feed.xml", allowed: false},
+		{url: "http://api.localhost/feed.xml", allowed: false},
+		{url: "http://[64:ff9b::7f00:1]/feed.xml", allowed: false},
+		{url: "http://[2002:a00:1::1]/feed.xml", allowed: false},
+		{url: "https://localhost.example.com/feed.xml", allowed: true},
+	}
+
+	for _, tc := range tests {
+		target, _ := neturl.Parse(tc.url)
+		err := policy.checkURL(target)
+		if (err == nil) != tc.allowed {
+			t.Errorf("checkURL(%s) = %v, want allowed %v", tc.url, err, tc.allowed)
+		}
+		if err != nil && !errors.Is(err, errBlockedByPolicy) {
+			t.Errorf("checkURL(%s) returned %v, want errBlockedByPolicy", tc.url, err)
+		}
+	}
+}
+
+func TestNewNetworkPolicy(t *testing.T) {
+	if policy, err := newNetworkPolicy(nil, nil); policy != nil || err != nil {
+		t.Errorf("expected no policy unless enabled, got %+v (%v)", policy, err)
+	}
+	if _, err := newNetworkPolicy(&pb.NetworkPolicy{Enabled: true, AllowedPorts: []int32{70000}}, nil); err == nil {
+		t.Error("expected error for an out of range port")
+	}
+
+	proxyURL, _ := neturl.Parse("http://proxy.internal")
+	policy, err := newNetworkPolicy(&pb.NetworkPolicy{Enabled: true, AllowedPorts: []int32{8080}}, proxyURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !policy.allowedPorts[8080] || policy.allowedPorts[443] || policy.proxyAddress != "proxy.internal:80" {
+		t.Errorf("unexpected policy: %+v", policy)
+	}
+}
+
+func TestNetworkPolicy_BlocksLocalhost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	serverURL, _ := neturl.Parse(server.URL)
+	port := int32(0)
+	if parsed, err := netip.ParseAddrPort(serverURL.Host); err == nil {
+		port = int32(parsed.Port())
+	}
+
+	client, err := newHTTPClient(&pb.ClientConfig{NetworkPolicy: &pb.NetworkPolicy{Enabled: true, AllowedPorts: []int32{port}}})
+	if err != nil {
+		t.Fatalf("failed to build client: %v", err)
+	}
+	parser := gofeed.NewParser()
+	parser.Client = client
+
+	// "localhost" is refused by name, so it stays blocked even when a proxy resolves it.
+	target := "http://localhost:" + serverURL.Port() + "/feed.xml"
+	_, err = fetchFeed(context.Background(), parser, target, nil, defaultMaxBodyBytes)
+	if !errors.Is(err, errBlockedByPolicy) {
+		t.Fatalf("expected errBlockedByPolicy, got %v", err)
+	}
+	if detail := newFetchErrorDetail(err, target); detail.Kind != pb.ErrorKind_ERROR_KIND_BLOCKED_BY_POLICY || detail.Retryable {
+		t.Errorf("expected non-retryable BLOCKED_BY_POLICY, got %v (retryable %v)", detail.Kind, detail.Retryable)
+	}
+}
+
+func TestNetworkPolicy_Control(t *testing.T) {
+	policy, err := newNetworkPolicy(&pb.NetworkPolicy{Enabled: true}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// control sees addresses after DNS resolution, so it catches names that resolve to internal hosts.
+	for _, address := range []string{"127.0.0.1:443", "[::1]:443", "[64:ff9b::a00:1]:443", "[2002:c0a8:101::1]:80"} {
+		if err := policy.control("tcp", address, nil); !errors.Is(err, errBlockedByPolicy) {
+			t.Errorf("control(%s) = %v, want errBlockedByPolicy", address, err)
+		}
+	}
+	if err := policy.control("tcp", "93.184.216.34:443", nil); err != nil {
+		t.Errorf("expected a public address to be allowed, got %v", err)
+	}
+}
+
+func TestNetworkPolicy_BlocksRedirects(t *testing.T) {
+	var redirected bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/private" {
+			redirected = true
+			return
+		}
+		_, port, _ := net.SplitHostPort(r.Host)
+		http.Redirect(w, r, "http://127.0.0.1:"+port+"/private", http.StatusFound)
+	}))
+	defer server.Close()
+
+	serverURL, _ := neturl.Parse(server.URL)
+	port, _ := netip.ParseAddrPort(serverURL.Host)
+	policy, err := newNetworkPolicy(&pb.NetworkPolicy{Enabled: true, AllowedPorts: []int32{int32(port.Port())}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Without the dial guard the first hop by hostname goes through; the redirect names a literal loopback address
+	// and must be refused before it is followed.
+	client := &http.Client{Transport: &policyTransport{next: http.DefaultTransport, policy: policy}}
+	_, err = client.Get("http://localhost:" + serverURL.Port() + "/feed.xml")
+	if !errors.Is(err, errBlockedByPolicy) {
+		t.Fatalf("expected the redirect to be blocked, got %v", err)
+	}
+	if redirected {
+		t.Error("expected the redirect target not to be requested")
+	}
+}
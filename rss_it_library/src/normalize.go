@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	neturl "net/url"
+	"strings"
+
+	"golang.org/x/net/idna"
+
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+// feedSchemes maps pseudo-schemes used by "subscribe" links onto the scheme to fetch with. They get the same https
+// default as an address without a scheme, so a subscribe link and the bare address it points to dedupe.
+var feedSchemes = map[string]string{
+	"feed":  "https",
+	"itpc":  "https",
+	"pcast": "https",
+}
+
+// hostProfile maps hostnames for lookup like idna.Lookup, but tolerates the underscores some real hosts use.
+var hostProfile = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.StrictDomainName(false))
+
+// trackingParams are query parameters that only carry campaign or click attribution.
+var trackingParams = map[string]bool{
+	"fbclid": true, "gclid": true, "dclid": true, "msclkid": true, "mc_cid": true, "mc_eid": true,
+	"igshid": true, "_hsenc": true, "_hsmi": true, "yclid": true,
+}
+
+// normalizeFeedURL turns a user-supplied feed address into its canonical form: a missing scheme and the feed:// and
+// podcast pseudo-schemes become https, the host is lowercased and converted to punycode, default ports and fragments
+// are dropped and an empty path becomes "/". Tracking parameters are removed when stripTracking is set. Only http(s)
+// URLs are accepted. An explicit http scheme and a trailing slash are kept, since servers may answer them differently.
+func normalizeFeedURL(raw string, stripTracking bool) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", fmt.Errorf("feed URL is empty")
+	}
+
+	if scheme, rest, ok := strings.Cut(raw, ":"); ok {
+		if mapped, isFeedScheme := feedSchemes[strings.ToLower(scheme)]; isFeedScheme {
+			// feed:https://example.com/ wraps a complete URL; feed://example.com/ only swaps the scheme.
+			if strings.HasPrefix(rest, "//") {
+				raw = mapped + ":" + rest
+			} else {
+				raw = rest
+			}
+		}
+	}
+	switch {
+	case strings.HasPrefix(raw, "//"):
+		raw = "https:" + raw
+	case !strings.Contains(raw, "://") && !hasOpaqueScheme(raw):
+		raw = "https://" + raw
+	}
+
+	parsed, err := neturl.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid feed URL: %w", err)
+	}
+
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", fmt.Errorf("unsupported URL scheme %q", parsed.Scheme)
+	}
+
+	host, err := normalizeHost(parsed.Hostname())
+	if err != nil {
+		return "", err
+	}
+	port := parsed.Port()
+	if (parsed.Scheme == "http" && port == "80") || (parsed.Scheme == "https" && port == "443") {
+		port = ""
+	}
+	parsed.Host = host
+	if strings.Contains(host, ":") {
+		parsed.Host = "[" + host + "]"
+	}
+	if port != "" {
+		parsed.Host = net.JoinHostPort(host, port)
+	}
+
+	parsed.Fragment = ""
+	parsed.RawFragment = ""
+	if parsed.Path == "" {
+		parsed.Path = "/"
+		parsed.RawPath = ""
+	}
+	if stripTracking && parsed.RawQuery != "" {
+		parsed.RawQuery = stripTrackingParams(parsed.RawQuery)
+	}
+	return parsed.String(), nil
+}
+
+// hasOpaqueScheme reports whether raw starts with a scheme not followed by "//", such as mailto:, as opposed to a
+// bare host with a port like example.com:8080.
+func hasOpaqueScheme(raw string) bool {
+	scheme, rest, ok := strings.Cut(raw, ":")
+	if !ok || scheme == "" || (rest != "" && rest[0] >= '0' && rest[0] <= '9') {
+		return false
+	}
+	for i, r := range scheme {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || i > 0 && (r >= '0' && r <= '9' || r == '+' || r == '-' || r == '.')) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeHost lowercases host, converts an internationalised name to punycode and drops a trailing dot.
+func normalizeHost(host string) (string, error) {
+	host = strings.TrimSuffix(host, ".")
+	if host == "" {
+		return "", fmt.Errorf("feed URL has no host")
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return strings.ToLower(host), nil
+	}
+
+	ascii, err := hostProfile.ToASCII(host)
+	if err != nil {
+		return "", fmt.Errorf("invalid host %q: %w", host, err)
+	}
+	return strings.ToLower(ascii), nil
+}
+
+// stripTrackingParams removes utm_* and other attribution parameters from a raw query, preserving the order and
+// encoding of everything else.
+func stripTrackingParams(rawQuery string) string {
+	kept := make([]string, 0)
+	for _, pair := range strings.Split(rawQuery, "&") {
+		name, _, _ := strings.Cut(pair, "=")
+		if decoded, err := neturl.QueryUnescape(name); err == nil {
+			name = decoded
+		}
+		name = strings.ToLower(name)
+		if pair == "" || strings.HasPrefix(name, "utm_") || trackingParams[name] {
+			continue
+		}
+		kept = append(kept, pair)
+	}
+	return strings.Join(kept, "&")
+}
+
+// NormalizeURLs returns the canonical form of each requested URL, or an error detail for those that cannot be
+// normalized. No network requests are made, so the app can use it to dedupe subscriptions.
+func NormalizeURLs(request *pb.NormalizeUrlsRequest) *pb.NormalizeUrlsResponse {
+	response := &pb.NormalizeUrlsResponse{Urls: make([]*pb.NormalizedUrl, 0, len(request.GetUrls()))}
+	for _, input := range request.GetUrls() {
+		normalized := &pb.NormalizedUrl{Input: input}
+		canonical, err := normalizeFeedURL(input, request.GetStripTrackingParams())
+		if err != nil {
+			normalized.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, err.Error(), input)
+		} else {
+			normalized.Canonical = canonical
+		}
+		response.Urls = append(response.Urls, normalized)
+	}
+	return response
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+func TestNormalizeFeedURL(t *testing.T) {
+	tests := []struct {
+		input         string
+		stripTracking bool
+		want          string
+	}{
+		{input: "example.com/feed", want: "https://example.com/feed"},
+		{input: "localhost:8080/feed", want: "https://localhost:8080/feed"},
+		{input: "  //example.com/feed ", want: "https://example.com/feed"},
+		{input: "HTTP://Example.COM:80/feed/#latest", want: "http://example.com/feed/"},
+		{input: "https://example.com:443", want: "https://example.com/"},
+		{input: "https://example.com:8443/feed", want: "https://example.com:8443/feed"},
+		{input: "https://example.com./feed", want: "https://example.com/feed"},
+		{input: "feed://example.com/rss", want: "https://example.com/rss"},
+		{input: "feed:https://example.com/rss", want: "https://example.com/rss"},
+		{input: "itpc://podcasts.example.com/show.xml", want: "https://podcasts.example.com/show.xml"},
+		{input: "pcast://podcasts.example.com/show.xml", want: "https://podcasts.example.com/show.xml"},
+		{input: "https://Bücher.example/feed", want: "https://xn--bcher-kva.example/feed"},
+		{input: "http://[::1]:80/feed", want: "http://[::1]/feed"},
+		{input: "https://example.com/feed?utm_source=x&page=2", want: "https://example.com/feed?utm_source=x&page=2"},
+		{input: "https://example.com/feed?utm_source=x&page=2&fbclid=abc&UTM_Medium=y", stripTracking: true, want: "https://example.com/feed?page=2"},
+		{input: "https://example.com/feed?utm_campaign=x", stripTracking: true, want: "https://example.com/feed"},
+	}
+
+	for _, tc := range tests {
+		got, err := normalizeFeedURL(tc.input, tc.stripTracking)
+		if err != nil {
+			t.Errorf("normalizeFeedURL(%q) returned error: %v", tc.input, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("normalizeFeedURL(%q) = %q, want %q", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizeFeedURL_SameFeed(t *testing.T) {
+	bare, err := normalizeFeedURL("example.com/feed", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	subscribe, err := normalizeFeedURL("feed://example.com/feed", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bare != subscribe {
+		t.Errorf("expected a bare address and its subscribe link to match, got %q and %q", bare, subscribe)
+	}
+
+	// An explicit http scheme and a trailing slash may name a different resource, so they are kept.
+	explicit, err := normalizeFeedURL("HTTP://Example.com/feed/", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if explicit != "http://example.com/feed/" {
+		t.Errorf("expected http://example.com/feed/, got %q", explicit)
+	}
+}
+
+func TestNormalizeFeedURL_Invalid(t *testing.T) {
+	for _, input := range []string{"", "   ", "ftp://example.com/feed", "mailto:news@example.com", "https://", "http://exa mple.com/"} {
+		if got, err := normalizeFeedURL(input, false); err == nil {
+			t.Errorf("normalizeFeedURL(%q) = %q, expected an error", input, got)
+		}
+	}
+}
+
+func TestNormalizeURLs(t *testing.T) {
+	response := NormalizeURLs(&pb.NormalizeUrlsRequest{
+		Urls:                []string{"Example.com/feed?utm_source=x", "ftp://example.com/feed"},
+		StripTrackingParams: true,
+	})
+
+	if len(response.Urls) != 2 {
+		t.Fatalf("expected two results, got %d", len(response.Urls))
+	}
+	if first := response.Urls[0]; first.Input != "Example.com/feed?utm_source=x" || first.Canonical != "https://example.com/feed" || first.Error != nil {
+		t.Errorf("unexpected result %v", first)
+	}
+	if second := response.Urls[1]; second.Canonical != "" || second.GetError().GetKind() != pb.ErrorKind_ERROR_KIND_VALIDATION {
+		t.Errorf("expected a validation error, got %v", second)
+	}
+}
+
+func TestRSSParser_ParseFeeds_CanonicalURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	input := "feed:" + server.URL + "/feed.xml#top"
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{input, "mailto:news@example.com"}})
+
+	if len(response.Feeds) != 1 || len(response.Errors) != 1 {
+		t.Fatalf("expected one feed and one error, got %d feeds and errors %v", len(response.Feeds), response.Errors)
+	}
+	if feed := response.Feeds[0]; feed.Url != input || feed.CanonicalUrl != server.URL+"/feed.xml" {
+		t.Errorf("expected input %q and canonical %q, got %q and %q", input, server.URL+"/feed.xml", feed.Url, feed.CanonicalUrl)
+	}
+	if detail := response.Errors[0]; detail.Kind != pb.ErrorKind_ERROR_KIND_VALIDATION {
+		t.Errorf("expected a validation error, got %v", detail)
+	}
+}
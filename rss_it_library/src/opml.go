@@ -0,0 +1,224 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+	"golang.org/x/sync/errgroup"
+
+	pb "github.com/sunderee/rss-it/proto"
+	goproto "google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultOPMLValidationConcurrency = 4
+	opmlVersion                      = "2.0"
+	defaultOPMLTitle                 = "RSS it subscriptions"
+)
+
+// opmlDocument mirrors the subset of OPML 2.0 used for subscription lists.
+type opmlDocument struct {
+	XMLName xml.Name `xml:"opml"`
+	Version string   `xml:"version,attr"`
+	Head    opmlHead `xml:"head"`
+	Body    opmlBody `xml:"body"`
+}
+
+type opmlHead struct {
+	Title       string `xml:"title,omitempty"`
+	DateCreated string `xml:"dateCreated,omitempty"`
+}
+
+type opmlBody struct {
+	Outlines []opmlOutline `xml:"outline"`
+}
+
+type opmlOutline struct {
+	Text     string        `xml:"text,attr"`
+	Title    string        `xml:"title,attr,omitempty"`
+	Type     string        `xml:"type,attr,omitempty"`
+	XMLURL   string        `xml:"xmlUrl,attr,omitempty"`
+	HTMLURL  string        `xml:"htmlUrl,attr,omitempty"`
+	Outlines []opmlOutline `xml:"outline"`
+}
+
+// UnmarshalXML decodes an outline, matching attribute names case-insensitively since exporters disagree on casing.
+func (o *opmlOutline) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		switch strings.ToLower(attr.Name.Local) {
+		case "text":
+			o.Text = attr.Value
+		case "title":
+			o.Title = attr.Value
+		case "type":
+			o.Type = attr.Value
+		case "xmlurl":
+			o.XMLURL = attr.Value
+		case "htmlurl":
+			o.HTMLURL = attr.Value
+		}
+	}
+
+	var children struct {
+		Outlines []opmlOutline `xml:"outline"`
+	}
+	if err := decoder.DecodeElement(&children, &start); err != nil {
+		return err
+	}
+	o.Outlines = children.Outlines
+	return nil
+}
+
+// OPMLImporter converts OPML subscription lists into protobuf form, optionally validating every feed.
+type OPMLImporter struct {
+	validator     *RSSValidator
+	maxConcurrent int
+}
+
+// NewOPMLImporter constructs an OPMLImporter that validates feeds with validator using up to maxConcurrent workers.
+func NewOPMLImporter(validator *RSSValidator, maxConcurrent int) *OPMLImporter {
+	if maxConcurrent <= 0 {
+		maxConcurrent = defaultOPMLValidationConcurrency
+	}
+	return &OPMLImporter{
+		validator:     validator,
+		maxConcurrent: maxConcurrent,
+	}
+}
+
+// ImportOPML parses the OPML document in the request and flattens its outlines into subscriptions.
+// Each subscription records the titles of its enclosing category outlines, outermost first.
+func (i *OPMLImporter) ImportOPML(ctx context.Context, request *pb.ImportOpmlRequest) *pb.ImportOpmlResponse {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	response := &pb.ImportOpmlResponse{Subscriptions: make([]*pb.OpmlSubscription, 0)}
+
+	if request == nil || len(bytes.TrimSpace(request.GetOpml())) == 0 {
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, "OPML document is empty", "")
+		return response
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(request.GetOpml()))
+	decoder.CharsetReader = charset.NewReaderLabel
+
+	var document opmlDocument
+	if err := decoder.Decode(&document); err != nil {
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_PARSING, fmt.Sprintf("decode OPML: %v", err), "")
+		return response
+	}
+
+	response.Title = strings.TrimSpace(document.Head.Title)
+	response.Subscriptions = flattenOutlines(document.Body.Outlines, nil, response.Subscriptions)
+
+	if request.GetValidate() && i.validator != nil {
+		i.validateSubscriptions(ctx, response.Subscriptions)
+	}
+
+	return response
+}
+
+// validateSubscriptions runs every subscription through the validator, recording the outcome in place.
+func (i *OPMLImporter) validateSubscriptions(ctx context.Context, subscriptions []*pb.OpmlSubscription) {
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(i.maxConcurrent)
+
+	for _, subscription := range subscriptions {
+		group.Go(func() error {
+			result := i.validator.ValidateFeedURL(groupCtx, &pb.ValidateFeedRequest{Url: subscription.GetXmlUrl()})
+			subscription.Valid = goproto.Bool(result.GetValid())
+			subscription.ValidationError = result.GetError()
+			return nil
+		})
+	}
+	_ = group.Wait()
+}
+
+// flattenOutlines walks outlines depth-first, appending feed outlines to subscriptions.
+// Outlines without an xmlUrl are treated as categories for their children.
+func flattenOutlines(outlines []opmlOutline, categories []string, subscriptions []*pb.OpmlSubscription) []*pb.OpmlSubscription {
+	for _, outline := range outlines {
+		title := strings.TrimSpace(outline.Title)
+		if title == "" {
+			title = strings.TrimSpace(outline.Text)
+		}
+
+		xmlURL := strings.TrimSpace(outline.XMLURL)
+		if xmlURL == "" {
+			nested := categories
+			if title != "" {
+				nested = append(append([]string(nil), categories...), title)
+			}
+			subscriptions = flattenOutlines(outline.Outlines, nested, subscriptions)
+			continue
+		}
+
+		subscription := &pb.OpmlSubscription{
+			Title:      title,
+			XmlUrl:     xmlURL,
+			Categories: append([]string(nil), categories...),
+		}
+		if htmlURL := strings.TrimSpace(outline.HTMLURL); htmlURL != "" {
+			subscription.HtmlUrl = goproto.String(htmlURL)
+		}
+		subscriptions = append(subscriptions, subscription)
+
+		// Some exporters nest feeds beneath feeds; keep them rather than silently dropping entries.
+		subscriptions = flattenOutlines(outline.Outlines, categories, subscriptions)
+	}
+	return subscriptions
+}
+
+// ExportOPML renders the feeds in the request as an OPML 2.0 document.
+func ExportOPML(request *pb.ExportOpmlRequest) *pb.ExportOpmlResponse {
+	response := &pb.ExportOpmlResponse{}
+
+	title := strings.TrimSpace(request.GetTitle())
+	if title == "" {
+		title = defaultOPMLTitle
+	}
+
+	document := opmlDocument{
+		Version: opmlVersion,
+		Head: opmlHead{
+			Title:       title,
+			DateCreated: time.Now().UTC().Format(time.RFC1123Z),
+		},
+		Body: opmlBody{Outlines: make([]opmlOutline, 0, len(request.GetFeeds()))},
+	}
+
+	for _, feed := range request.GetFeeds() {
+		feedURL := strings.TrimSpace(feed.GetUrl())
+		if feedURL == "" {
+			continue
+		}
+
+		text := strings.TrimSpace(feed.GetTitle())
+		if text == "" {
+			text = feedURL
+		}
+
+		document.Body.Outlines = append(document.Body.Outlines, opmlOutline{
+			Text:    text,
+			Title:   text,
+			Type:    "rss",
+			XMLURL:  feedURL,
+			HTMLURL: strings.TrimSpace(feed.GetLink()),
+		})
+	}
+
+	payload, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_SERIALIZATION, fmt.Sprintf("encode OPML: %v", err), "")
+		return response
+	}
+
+	response.Opml = append([]byte(xml.Header), payload...)
+	return response
+}
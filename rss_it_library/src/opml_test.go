@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+	goproto "google.golang.org/protobuf/proto"
+)
+
+const testOPML = `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head><title>My subscriptions</title></head>
+  <body>
+    <outline text="Top level" type="rss" xmlUrl="https://example.com/top.xml" htmlUrl="https://example.com/"/>
+    <outline text="Tech">
+      <outline text="Go" title="The Go Blog" type="rss" xmlUrl="https://go.dev/blog/feed.atom"/>
+      <outline title="Nested">
+        <outline text="Deep" type="rss" xmlurl="https://deep.example.com/rss" htmlurl="https://deep.example.com/"/>
+      </outline>
+    </outline>
+    <outline text="Empty category"/>
+  </body>
+</opml>`
+
+func TestOPMLImporter_ImportNested(t *testing.T) {
+	importer := NewOPMLImporter(nil, defaultOPMLValidationConcurrency)
+	response := importer.ImportOPML(context.Background(), &pb.ImportOpmlRequest{Opml: []byte(testOPML)})
+
+	if response.Error != nil {
+		t.Fatalf("unexpected error: %v", response.Error)
+	}
+	if response.Title != "My subscriptions" {
+		t.Errorf("expected document title, got %q", response.Title)
+	}
+
+	expected := []struct {
+		title      string
+		xmlURL     string
+		htmlURL    string
+		categories []string
+	}{
+		{title: "Top level", xmlURL: "https://example.com/top.xml", htmlURL: "https://example.com/"},
+		{title: "The Go Blog", xmlURL: "https://go.dev/blog/feed.atom", categories: []string{"Tech"}},
+		{title: "Deep", xmlURL: "https://deep.example.com/rss", htmlURL: "https://deep.example.com/", categories: []string{"Tech", "Nested"}},
+	}
+
+	if len(response.Subscriptions) != len(expected) {
+		t.Fatalf("expected %d subscriptions, got %d", len(expected), len(response.Subscriptions))
+	}
+	for i, want := range expected {
+		got := response.Subscriptions[i]
+		if got.Title != want.title || got.XmlUrl != want.xmlURL || got.GetHtmlUrl() != want.htmlURL {
+			t.Errorf("subscription %d: expected %+v, got %v", i, want, got)
+		}
+		if strings.Join(got.Categories, "/") != strings.Join(want.categories, "/") {
+			t.Errorf("subscription %d: expected categories %v, got %v", i, want.categories, got.Categories)
+		}
+		if got.Valid != nil {
+			t.Errorf("subscription %d: expected validity to be unset without validation", i)
+		}
+	}
+}
+
+func TestOPMLImporter_InvalidDocument(t *testing.T) {
+	importer := NewOPMLImporter(nil, defaultOPMLValidationConcurrency)
+
+	tests := []struct {
+		name     string
+		opml     []byte
+		expected pb.ErrorKind
+	}{
+		{name: "empty", opml: nil, expected: pb.ErrorKind_ERROR_KIND_VALIDATION},
+		{name: "not xml", opml: []byte("not opml"), expected: pb.ErrorKind_ERROR_KIND_PARSING},
+		{name: "wrong root", opml: []byte("<rss></rss>"), expected: pb.ErrorKind_ERROR_KIND_PARSING},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response := importer.ImportOPML(context.Background(), &pb.ImportOpmlRequest{Opml: tc.opml})
+			if response.Error == nil || response.Error.Kind != tc.expected {
+				t.Fatalf("expected %v error, got %v", tc.expected, response.Error)
+			}
+		})
+	}
+}
+
+func TestOPMLImporter_Validate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/good.xml" {
+			_, _ = w.Write([]byte(testRSSFeed))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	opml := `<opml version="2.0"><body>
+  <outline text="Good" xmlUrl="` + server.URL + `/good.xml"/>
+  <outline text="Missing" xmlUrl="` + server.URL + `/missing.xml"/>
+</body></opml>`
+
+	validator := NewRSSValidator(gofeed.NewParser, defaultValidationTimeout)
+	importer := NewOPMLImporter(validator, defaultOPMLValidationConcurrency)
+	response := importer.ImportOPML(context.Background(), &pb.ImportOpmlRequest{Opml: []byte(opml), Validate: true})
+
+	if len(response.Subscriptions) != 2 {
+		t.Fatalf("expected 2 subscriptions, got %d", len(response.Subscriptions))
+	}
+	good, missing := response.Subscriptions[0], response.Subscriptions[1]
+	if good.Valid == nil || !good.GetValid() || good.ValidationError != nil {
+		t.Errorf("expected first subscription to validate, got %v", good)
+	}
+	if missing.Valid == nil || missing.GetValid() || missing.ValidationError == nil {
+		t.Errorf("expected second subscription to fail validation, got %v", missing)
+	}
+}
+
+func TestExportOPML_RoundTrip(t *testing.T) {
+	response := ExportOPML(&pb.ExportOpmlRequest{
+		Title: "Exported",
+		Feeds: []*pb.Feed{
+			{Url: "https://example.com/rss.xml", Title: "Example & Co", Link: goproto.String("https://example.com/")},
+			{Url: "https://untitled.example.com/feed"},
+			{Url: "   "},
+		},
+	})
+	if response.Error != nil {
+		t.Fatalf("unexpected error: %v", response.Error)
+	}
+	if !strings.Contains(string(response.Opml), `<opml version="2.0">`) {
+		t.Errorf("expected OPML 2.0 root element, got %s", response.Opml)
+	}
+
+	imported := NewOPMLImporter(nil, defaultOPMLValidationConcurrency).ImportOPML(context.Background(), &pb.ImportOpmlRequest{Opml: response.Opml})
+	if imported.Error != nil {
+		t.Fatalf("unexpected import error: %v", imported.Error)
+	}
+	if imported.Title != "Exported" {
+		t.Errorf("expected title to round-trip, got %q", imported.Title)
+	}
+	if len(imported.Subscriptions) != 2 {
+		t.Fatalf("expected 2 subscriptions, got %d", len(imported.Subscriptions))
+	}
+
+	first := imported.Subscriptions[0]
+	if first.Title != "Example & Co" || first.XmlUrl != "https://example.com/rss.xml" || first.GetHtmlUrl() != "https://example.com/" {
+		t.Errorf("unexpected first subscription: %v", first)
+	}
+	second := imported.Subscriptions[1]
+	if second.Title != "https://untitled.example.com/feed" || second.HtmlUrl != nil {
+		t.Errorf("expected untitled feed to fall back to its URL, got %v", second)
+	}
+}
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -16,16 +18,19 @@ import (
 
 const (
 	defaultParserConcurrency = 8
+	maxParserConcurrency     = 32
 )
 
 // RSSParser coordinates concurrent feed downloads while converting them to protobuf responses.
 type RSSParser struct {
 	newParser     func() *gofeed.Parser
 	maxConcurrent int
+	cache         *FeedCache
 }
 
-// NewRSSParser constructs an RSSParser with the provided parser factory and concurrency limit.
-func NewRSSParser(newParser func() *gofeed.Parser, maxConcurrent int) *RSSParser {
+// NewRSSParser constructs an RSSParser with the provided parser factory, concurrency limit and feed cache. A nil
+// cache disables caching.
+func NewRSSParser(newParser func() *gofeed.Parser, maxConcurrent int, cache *FeedCache) *RSSParser {
 	if newParser == nil {
 		newParser = gofeed.NewParser
 	}
@@ -35,86 +40,225 @@ func NewRSSParser(newParser func() *gofeed.Parser, maxConcurrent int) *RSSParser
 	return &RSSParser{
 		newParser:     newParser,
 		maxConcurrent: maxConcurrent,
+		cache:         cache,
 	}
 }
 
-// ParseFeeds fetches and normalises all feeds in the request, aggregating successes and error details.
+// ParseFeeds fetches and normalises all feeds in the request, aggregating successes and error details. Results are
+// in completion order unless the request asks to preserve the order of its URLs.
 func (p *RSSParser) ParseFeeds(ctx context.Context, request *pb.ParseFeedsRequest) *pb.ParseFeedsResponse {
-	if ctx == nil {
-		ctx = context.Background()
-	}
-
 	response := &pb.ParseFeedsResponse{
 		Status: pb.ParseFeedsStatus_ERROR,
 		Feeds:  make([]*pb.Feed, 0, len(request.GetUrls())),
 		Errors: make([]*pb.ErrorDetail, 0),
 	}
 
-	urls := request.GetUrls()
-	if len(urls) == 0 {
-		response.Errors = append(response.Errors, newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, "no feed URLs supplied", ""))
-		return response
+	summary := p.StreamFeeds(ctx, request, func(event *pb.ParseStreamEvent) {
+		switch payload := event.GetEvent().(type) {
+		case *pb.ParseStreamEvent_Feed:
+			response.Feeds = append(response.Feeds, payload.Feed)
+		case *pb.ParseStreamEvent_Error:
+			response.Errors = append(response.Errors, payload.Error)
+		}
+	})
+
+	// Feeds that redirected to the same location can only name each other once all of them are known.
+	mergeFinalLocations(response.Feeds, request.GetStripTrackingParams())
+	if request.GetPreserveOrder() {
+		sortByInputIndex(response)
+	}
+	response.Status = summary.GetStatus()
+	return response
+}
+
+// StreamFeeds fetches the feeds in the request like ParseFeeds, but hands every feed and per-feed error to emit
+// as soon as it completes. A summary event is always emitted last and returned. emit is never called concurrently.
+func (p *RSSParser) StreamFeeds(ctx context.Context, request *pb.ParseFeedsRequest, emit func(*pb.ParseStreamEvent)) *pb.ParseStreamSummary {
+	if ctx == nil {
+		ctx = context.Background()
 	}
 
 	var (
-		mu     sync.Mutex
-		feeds  = make([]*pb.Feed, 0, len(urls))
-		errors = make([]*pb.ErrorDetail, 0)
+		mu      sync.Mutex
+		summary = &pb.ParseStreamSummary{Status: pb.ParseFeedsStatus_ERROR}
 	)
 
+	publish := func(event *pb.ParseStreamEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch event.GetEvent().(type) {
+		case *pb.ParseStreamEvent_Feed:
+			summary.FeedCount++
+		case *pb.ParseStreamEvent_Error:
+			summary.ErrorCount++
+		}
+		emit(event)
+	}
+
+	defer func() {
+		summary.Status = parseStatus(int(summary.FeedCount), int(summary.ErrorCount))
+		emit(&pb.ParseStreamEvent{Event: &pb.ParseStreamEvent_Summary{Summary: summary}})
+	}()
+
+	urls := request.GetUrls()
+	if len(urls) == 0 {
+		publish(errorEvent(newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, "no feed URLs supplied", "")))
+		return summary
+	}
+
+	stripTracking := request.GetStripTrackingParams()
+	validators := make(map[string]*pb.FeedValidators, len(request.GetValidators()))
+	for _, entry := range request.GetValidators() {
+		if key, err := normalizeFeedURL(entry.GetUrl(), stripTracking); err == nil {
+			validators[key] = entry
+		}
+	}
+
+	feedTimeout := durationOrDefault(request.GetFeedTimeoutMs(), 0)
+	retry := newRetryPolicy(request.GetRetry())
+	options := newConversionOptions(request)
+	variant := cacheVariant{contentFormat: request.GetContentFormat(), openGraph: request.GetFetchOgImage(), limits: options.limits}
+	readCache := !request.GetBypassCache() && !request.GetRefreshCache()
+
 	group, groupCtx := errgroup.WithContext(ctx)
-	group.SetLimit(p.maxConcurrent)
-
-	for _, candidate := range urls {
-		rawURL := strings.TrimSpace(candidate)
-		if rawURL == "" {
-			mu.Lock()
-			errors = append(errors, newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, "feed URL is empty", candidate))
-			mu.Unlock()
-			continue
+	group.SetLimit(p.concurrencyFor(request))
+
+	// Inputs sharing a canonical URL are fetched once and the result is fanned out to each of them.
+	flights, invalid := groupFeedURLs(urls, stripTracking)
+	for _, detail := range invalid {
+		publish(errorEvent(detail))
+	}
+
+	locations := newFinalLocations(stripTracking)
+	publishFeed := func(flight *feedFlight, feed *pb.Feed) {
+		for _, copied := range flight.feeds(feed, locations.claim(feed, flight.inputs)) {
+			publish(feedEvent(copied))
+		}
+	}
+
+	for _, flight := range flights {
+		// Feeds are fetched and cached by their canonical URL but reported under the URLs the caller asked for.
+		canonicalURL := flight.canonicalURL
+		if readCache {
+			if feed := p.cache.get(canonicalURL, variant); feed != nil {
+				publishFeed(flight, feed)
+				continue
+			}
 		}
 
-		feedURL := rawURL
 		group.Go(func() error {
 			parser := p.newParser()
 
-			feed, err := parser.ParseURLWithContext(feedURL, groupCtx)
-			mu.Lock()
-			defer mu.Unlock()
+			feedCtx := groupCtx
+			if feedTimeout > 0 {
+				var cancel context.CancelFunc
+				feedCtx, cancel = context.WithTimeout(groupCtx, feedTimeout)
+				defer cancel()
+			}
 
+			result, err := fetchFeedWithRetry(feedCtx, parser, canonicalURL, validators[canonicalURL], retry, options.limits.maxBodyBytes)
 			if err != nil {
-				errors = append(errors, newErrorDetail(classifyParseError(err), err.Error(), feedURL))
+				detail := newFetchErrorDetail(err, canonicalURL)
+				detail.Diagnostics = result.diagnostics
+				for _, copied := range flight.errors(detail) {
+					publish(errorEvent(copied))
+				}
 				return nil
 			}
 
-			feeds = append(feeds, toProtoFeed(feedURL, feed))
+			feed := toProtoFetchedFeed(canonicalURL, result, options)
+			feed.CanonicalUrl = canonicalURL
+			if request.GetFetchOgImage() {
+				fillOpenGraphImages(feedCtx, parser, feed)
+			}
+			if !request.GetBypassCache() {
+				p.cache.put(canonicalURL, variant, feed)
+			}
+			publishFeed(flight, feed)
 			return nil
 		})
 	}
 
 	if err := group.Wait(); err != nil {
-		mu.Lock()
-		errors = append(errors, newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, err.Error(), ""))
-		mu.Unlock()
+		publish(errorEvent(newErrorDetail(pb.ErrorKind_ERROR_KIND_INTERNAL, err.Error(), "")))
 	}
 
-	response.Feeds = feeds
-	response.Errors = errors
+	return summary
+}
 
+// sortByInputIndex orders feeds and errors by the position of their URL in the request. Errors that do not belong
+// to a single input, such as a cancelled request, come last.
+func sortByInputIndex(response *pb.ParseFeedsResponse) {
+	slices.SortStableFunc(response.Feeds, func(a, b *pb.Feed) int {
+		return cmp.Compare(a.GetInputIndex(), b.GetInputIndex())
+	})
+	slices.SortStableFunc(response.Errors, func(a, b *pb.ErrorDetail) int {
+		if (a.InputIndex == nil) != (b.InputIndex == nil) {
+			if a.InputIndex == nil {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(a.GetInputIndex(), b.GetInputIndex())
+	})
+}
+
+// concurrencyFor returns the worker limit for request, honouring its override up to maxParserConcurrency.
+func (p *RSSParser) concurrencyFor(request *pb.ParseFeedsRequest) int {
+	limit := int(request.GetMaxConcurrency())
+	if limit <= 0 {
+		return p.maxConcurrent
+	}
+	return min(limit, maxParserConcurrency)
+}
+
+// parseStatus derives the overall status from the number of parsed feeds and reported errors.
+func parseStatus(feeds, errors int) pb.ParseFeedsStatus {
 	switch {
-	case len(feeds) == 0:
-		response.Status = pb.ParseFeedsStatus_ERROR
-	case len(errors) == 0:
-		response.Status = pb.ParseFeedsStatus_SUCCESS
+	case feeds == 0:
+		return pb.ParseFeedsStatus_ERROR
+	case errors == 0:
+		return pb.ParseFeedsStatus_SUCCESS
 	default:
-		response.Status = pb.ParseFeedsStatus_PARTIAL
+		return pb.ParseFeedsStatus_PARTIAL
 	}
+}
 
-	return response
+// feedEvent wraps a parsed feed in a stream event.
+func feedEvent(feed *pb.Feed) *pb.ParseStreamEvent {
+	return &pb.ParseStreamEvent{Event: &pb.ParseStreamEvent_Feed{Feed: feed}}
+}
+
+// errorEvent wraps a per-feed error in a stream event.
+func errorEvent(detail *pb.ErrorDetail) *pb.ParseStreamEvent {
+	return &pb.ParseStreamEvent{Event: &pb.ParseStreamEvent_Error{Error: detail}}
+}
+
+// toProtoFetchedFeed converts a fetch result into a protobuf Feed, carrying cache validators, 304 status and diagnostics.
+func toProtoFetchedFeed(feedURL string, result *fetchResult, options conversionOptions) *pb.Feed {
+	var feed *pb.Feed
+	if result.notModified {
+		feed = &pb.Feed{Url: feedURL, NotModified: true}
+	} else {
+		options = options.forFetch(result)
+		feed = toProtoFeed(feedURL, result.feed, options)
+		_, resolver := options.feedResolvers(feedURL, result.feed)
+		result.jsonFeed.applyJSONFeedDetails(feed, result.feed, resolver, options.limits)
+	}
+
+	if result.etag != "" {
+		feed.Etag = goproto.String(result.etag)
+	}
+	if result.lastModified != "" {
+		feed.LastModified = goproto.String(result.lastModified)
+	}
+	feed.Diagnostics = result.diagnostics
+	return feed
 }
 
 // toProtoFeed converts a gofeed.Feed into the protobuf representation, sanitising content fields.
-func toProtoFeed(feedURL string, feed *gofeed.Feed) *pb.Feed {
+func toProtoFeed(feedURL string, feed *gofeed.Feed, options conversionOptions) *pb.Feed {
 	if feed == nil {
 		return &pb.Feed{
 			Url:   feedURL,
@@ -122,52 +266,63 @@ func toProtoFeed(feedURL string, feed *gofeed.Feed) *pb.Feed {
 		}
 	}
 
-	cleanDescription := cleanString(feed.Description)
+	channel, resolver := options.feedResolvers(feedURL, feed)
+	options.feedType = feed.FeedType
+
+	cleanDescription := plainText(feed.Description, channel.base)
 	var descriptionPtr *string
 	if cleanDescription != "" {
 		descriptionPtr = goproto.String(cleanDescription)
 	}
 
 	var imagePtr *string
-	if feed.Image != nil && feed.Image.URL != "" {
-		imagePtr = goproto.String(feed.Image.URL)
+	if feed.Image != nil {
+		imagePtr = resolver.resolveOptional(feed.Image.URL)
+	}
+
+	sourceItems := feed.Items
+	truncated := false
+	if options.limits.maxItems > 0 && len(sourceItems) > options.limits.maxItems {
+		sourceItems, truncated = sourceItems[:options.limits.maxItems], true
 	}
 
-	items := make([]*pb.FeedItem, 0, len(feed.Items))
-	for _, item := range feed.Items {
-		items = append(items, toProtoFeedItem(item))
+	items := make([]*pb.FeedItem, 0, len(sourceItems))
+	for index, item := range sourceItems {
+		converted := toProtoFeedItem(item, options.itemResolver(index, resolver), options)
+		truncated = truncated || converted.Truncated
+		items = append(items, converted)
 	}
 
-	return &pb.Feed{
+	converted := &pb.Feed{
 		Url:         feedURL,
 		Title:       cleanString(feed.Title),
 		Description: descriptionPtr,
 		Image:       imagePtr,
 		Items:       items,
+		Link:        channel.resolveOptional(feed.Link),
+		Podcast:     toProtoPodcastChannel(feed.ITunesExt, resolver),
+		Truncated:   truncated,
 	}
+	options.limits.limitFeed(converted)
+	return converted
 }
 
-// toProtoFeedItem translates a gofeed.Item into protobuf form, normalising optional fields.
-func toProtoFeedItem(item *gofeed.Item) *pb.FeedItem {
+// toProtoFeedItem translates a gofeed.Item into protobuf form, normalising optional fields and resolving URLs
+// with resolver.
+func toProtoFeedItem(item *gofeed.Item, resolver urlResolver, options conversionOptions) *pb.FeedItem {
 	if item == nil {
 		return &pb.FeedItem{}
 	}
 
-	cleanDesc := cleanString(item.Description)
+	link := resolver.resolve(item.Link)
+	contentBase := resolver.rebase(link).base
+	cleanDesc, content := itemBody(item, contentBase, options)
 	var descriptionPtr *string
 	if cleanDesc != "" {
 		descriptionPtr = goproto.String(cleanDesc)
 	}
 
-	var linkPtr *string
-	if item.Link != "" {
-		linkPtr = goproto.String(item.Link)
-	}
-
-	var imagePtr *string
-	if item.Image != nil && item.Image.URL != "" {
-		imagePtr = goproto.String(item.Image.URL)
-	}
+	image, imageSource := itemImage(item, resolver, contentBase, options)
 
 	var publishedPtr *string
 	if item.PublishedParsed != nil {
@@ -175,13 +330,30 @@ func toProtoFeedItem(item *gofeed.Item) *pb.FeedItem {
 		publishedPtr = goproto.String(formatted)
 	}
 
-	return &pb.FeedItem{
+	converted := &pb.FeedItem{
 		Title:       cleanString(item.Title),
 		Description: descriptionPtr,
-		Link:        linkPtr,
-		Image:       imagePtr,
+		Link:        optionalString(link),
+		Image:       optionalString(image),
+		ImageSource: imageSource,
 		Published:   publishedPtr,
+		Enclosures:  toProtoEnclosures(item.Enclosures, resolver),
+		Podcast:     toProtoPodcastEpisode(item.ITunesExt, resolver),
+		Id:          itemID(item),
+		Guid:        optionalString(strings.TrimSpace(item.GUID)),
+		ContentHash: itemContentHash(item),
+		Content:     optionalString(content),
+	}
+	options.limits.limitItem(converted, options.contentFormat)
+	return converted
+}
+
+// optionalString returns a pointer to value, or nil when value is empty.
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
 	}
+	return goproto.String(value)
 }
 
 // cleanString removes HTML tags, decodes common entities and fixes whitespace artefacts.
@@ -2,11 +2,15 @@ package main
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/mmcdole/gofeed"
 	pb "github.com/sunderee/rss-it/proto"
+	goproto "google.golang.org/protobuf/proto"
 )
 
 func TestCleanString(t *testing.T) {
@@ -131,7 +135,7 @@ func TestCleanWhitespace(t *testing.T) {
 }
 
 func TestRSSParser_ParseFeeds_EmptyRequest(t *testing.T) {
-	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency)
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
 	request := &pb.ParseFeedsRequest{
 		Urls: []string{},
 	}
@@ -152,7 +156,7 @@ func TestRSSParser_ParseFeeds_EmptyRequest(t *testing.T) {
 func TestRSSParser_ParseFeeds_StatusSuccess(t *testing.T) {
 	// Note: This test requires network access or a mock parser
 	// For now, we'll test the status logic with a mock scenario
-	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency)
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
 
 	// Test with a known valid RSS feed URL
 	// Using a well-known public RSS feed for testing
@@ -171,7 +175,7 @@ func TestRSSParser_ParseFeeds_StatusSuccess(t *testing.T) {
 }
 
 func TestRSSParser_ParseFeeds_StatusPartial(t *testing.T) {
-	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency)
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
 
 	// Test with mix of valid and invalid URLs
 	request := &pb.ParseFeedsRequest{
@@ -192,7 +196,7 @@ func TestRSSParser_ParseFeeds_StatusPartial(t *testing.T) {
 }
 
 func TestRSSParser_ParseFeeds_ConcurrentParsing(t *testing.T) {
-	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency)
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
 
 	// Test concurrent parsing with multiple URLs
 	request := &pb.ParseFeedsRequest{
@@ -221,7 +225,7 @@ func TestRSSParser_ParseFeeds_ConcurrentParsing(t *testing.T) {
 }
 
 func TestRSSParser_ParseFeeds_FeedItemConversion(t *testing.T) {
-	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency)
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
 
 	// Test with a feed that should have items
 	request := &pb.ParseFeedsRequest{
@@ -250,7 +254,7 @@ func TestRSSParser_ParseFeeds_FeedItemConversion(t *testing.T) {
 }
 
 func TestRSSParser_ParseFeeds_ErrorHandling(t *testing.T) {
-	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency)
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
 
 	// Test with invalid URL
 	request := &pb.ParseFeedsRequest{
@@ -271,7 +275,7 @@ func TestRSSParser_ParseFeeds_ErrorHandling(t *testing.T) {
 }
 
 func TestRSSParser_ParseFeeds_AllInvalid(t *testing.T) {
-	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency)
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
 
 	request := &pb.ParseFeedsRequest{
 		Urls: []string{
@@ -293,3 +297,216 @@ func TestRSSParser_ParseFeeds_AllInvalid(t *testing.T) {
 	}
 }
 
+func TestRSSParser_StreamFeeds_EmitsIncrementally(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer slow.Close()
+	defer close(release)
+
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer fast.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	request := &pb.ParseFeedsRequest{Urls: []string{slow.URL, fast.URL, " "}}
+
+	events := make(chan *pb.ParseStreamEvent, 8)
+	done := make(chan *pb.ParseStreamSummary, 1)
+	go func() {
+		done <- parser.StreamFeeds(context.Background(), request, func(event *pb.ParseStreamEvent) {
+			events <- event
+		})
+	}()
+
+	// The empty URL and the fast feed must arrive while the slow feed is still blocked.
+	seen := map[string]bool{}
+	for len(seen) < 2 {
+		select {
+		case event := <-events:
+			switch payload := event.GetEvent().(type) {
+			case *pb.ParseStreamEvent_Feed:
+				seen[payload.Feed.Url] = true
+			case *pb.ParseStreamEvent_Error:
+				seen["error"] = true
+			case *pb.ParseStreamEvent_Summary:
+				t.Fatal("summary emitted before all feeds completed")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for incremental events")
+		}
+	}
+	if !seen[fast.URL] || !seen["error"] {
+		t.Fatalf("expected fast feed and empty URL error first, got %v", seen)
+	}
+
+	release <- struct{}{}
+
+	var last *pb.ParseStreamEvent
+	for last.GetSummary() == nil {
+		select {
+		case last = <-events:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the summary event")
+		}
+	}
+	if len(events) != 0 {
+		t.Errorf("expected the summary to be the final event, %d events followed it", len(events))
+	}
+
+	summary := <-done
+	if summary.Status != pb.ParseFeedsStatus_PARTIAL || summary.FeedCount != 2 || summary.ErrorCount != 1 {
+		t.Errorf("unexpected summary %v", summary)
+	}
+}
+
+func TestRSSParser_StreamFeeds_EmptyRequest(t *testing.T) {
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+
+	var events []*pb.ParseStreamEvent
+	summary := parser.StreamFeeds(context.Background(), &pb.ParseFeedsRequest{}, func(event *pb.ParseStreamEvent) {
+		events = append(events, event)
+	})
+
+	if len(events) != 2 || events[0].GetError() == nil || events[1].GetSummary() == nil {
+		t.Fatalf("expected validation error followed by summary, got %v", events)
+	}
+	if summary.Status != pb.ParseFeedsStatus_ERROR {
+		t.Errorf("expected ERROR status, got %v", summary.Status)
+	}
+}
+
+func TestRSSParser_ParseFeeds_FeedTimeout(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer slow.Close()
+
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer fast.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	start := time.Now()
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{
+		Urls:          []string{slow.URL, fast.URL},
+		FeedTimeoutMs: 100,
+	})
+
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected per-feed timeout to bound the request, took %v", elapsed)
+	}
+	if response.Status != pb.ParseFeedsStatus_PARTIAL {
+		t.Fatalf("expected PARTIAL status, got %v", response.Status)
+	}
+	if len(response.Errors) != 1 || response.Errors[0].Url != slow.URL {
+		t.Errorf("expected only the slow feed to fail, got %v", response.Errors)
+	}
+}
+
+func TestRSSParser_ParseFeeds_MaxConcurrencyOverride(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			observed := peak.Load()
+			if current <= observed || peak.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{
+		Urls:           []string{server.URL + "/a", server.URL + "/b", server.URL + "/c", server.URL + "/d"},
+		MaxConcurrency: 1,
+	})
+
+	if response.Status != pb.ParseFeedsStatus_SUCCESS {
+		t.Fatalf("expected SUCCESS, got %v (%v)", response.Status, response.Errors)
+	}
+	if peak.Load() != 1 {
+		t.Errorf("expected at most one concurrent fetch, observed %d", peak.Load())
+	}
+}
+
+func TestRSSParser_ConcurrencyFor(t *testing.T) {
+	parser := NewRSSParser(gofeed.NewParser, 4, nil)
+
+	tests := []struct {
+		name     string
+		override int32
+		expected int
+	}{
+		{name: "default", override: 0, expected: 4},
+		{name: "negative falls back", override: -3, expected: 4},
+		{name: "override", override: 12, expected: 12},
+		{name: "capped", override: 1000, expected: maxParserConcurrency},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := parser.concurrencyFor(&pb.ParseFeedsRequest{MaxConcurrency: tc.override})
+			if got != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestRSSParser_ParseFeeds_PreserveOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow":
+			time.Sleep(100 * time.Millisecond)
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	urls := []string{server.URL + "/slow", "  ", server.URL + "/missing", "  " + server.URL + "/fast  "}
+
+	unordered := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: urls})
+	if len(unordered.Feeds) != 2 || unordered.Feeds[0].InputIndex != 3 {
+		t.Fatalf("expected the fast feed to complete first, got %v", unordered.Feeds)
+	}
+
+	ordered := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: urls, PreserveOrder: true})
+	if len(ordered.Feeds) != 2 || len(ordered.Errors) != 2 {
+		t.Fatalf("expected two feeds and two errors, got %d and %v", len(ordered.Feeds), ordered.Errors)
+	}
+	if ordered.Feeds[0].InputIndex != 0 || ordered.Feeds[1].InputIndex != 3 {
+		t.Errorf("expected feeds in input order, got indices %d and %d", ordered.Feeds[0].InputIndex, ordered.Feeds[1].InputIndex)
+	}
+	if ordered.Errors[0].GetInputIndex() != 1 || ordered.Errors[1].GetInputIndex() != 2 {
+		t.Errorf("expected errors in input order, got %v", ordered.Errors)
+	}
+}
+
+func TestSortByInputIndex_RequestErrorsLast(t *testing.T) {
+	response := &pb.ParseFeedsResponse{Errors: []*pb.ErrorDetail{
+		{Message: "cancelled"},
+		{Message: "second", InputIndex: goproto.Int32(2)},
+		{Message: "first", InputIndex: goproto.Int32(0)},
+	}}
+	sortByInputIndex(response)
+
+	if response.Errors[0].Message != "first" || response.Errors[1].Message != "second" || response.Errors[2].Message != "cancelled" {
+		t.Errorf("unexpected order %v", response.Errors)
+	}
+}
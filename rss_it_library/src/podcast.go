@@ -0,0 +1,138 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+	ext "github.com/mmcdole/gofeed/extensions"
+
+	pb "github.com/sunderee/rss-it/proto"
+	goproto "google.golang.org/protobuf/proto"
+)
+
+// toProtoEnclosures converts item enclosures, skipping entries without a usable URL.
+func toProtoEnclosures(enclosures []*gofeed.Enclosure, resolver urlResolver) []*pb.Enclosure {
+	if len(enclosures) == 0 {
+		return nil
+	}
+
+	result := make([]*pb.Enclosure, 0, len(enclosures))
+	for _, enclosure := range enclosures {
+		if enclosure == nil {
+			continue
+		}
+		enclosureURL := resolver.resolve(enclosure.URL)
+		if enclosureURL == "" {
+			continue
+		}
+
+		converted := &pb.Enclosure{
+			Url:      enclosureURL,
+			MimeType: optionalString(strings.TrimSpace(enclosure.Type)),
+		}
+		// Length is frequently "0" or garbage in the wild; only report plausible values.
+		if length, err := strconv.ParseInt(strings.TrimSpace(enclosure.Length), 10, 64); err == nil && length > 0 {
+			converted.Length = goproto.Int64(length)
+		}
+		result = append(result, converted)
+	}
+	return result
+}
+
+// toProtoPodcastEpisode maps the iTunes item extension onto a PodcastEpisode, returning nil when absent.
+func toProtoPodcastEpisode(itunes *ext.ITunesItemExtension, resolver urlResolver) *pb.PodcastEpisode {
+	if itunes == nil {
+		return nil
+	}
+
+	episode := &pb.PodcastEpisode{
+		Duration:    optionalString(strings.TrimSpace(itunes.Duration)),
+		Explicit:    parseITunesFlag(itunes.Explicit),
+		Image:       resolver.resolveOptional(itunes.Image),
+		EpisodeType: optionalString(strings.ToLower(strings.TrimSpace(itunes.EpisodeType))),
+	}
+	if seconds, ok := parseITunesDuration(itunes.Duration); ok {
+		episode.DurationSeconds = goproto.Int64(seconds)
+	}
+	if number, err := strconv.ParseInt(strings.TrimSpace(itunes.Episode), 10, 32); err == nil {
+		episode.Episode = goproto.Int32(int32(number))
+	}
+	if number, err := strconv.ParseInt(strings.TrimSpace(itunes.Season), 10, 32); err == nil {
+		episode.Season = goproto.Int32(int32(number))
+	}
+	return episode
+}
+
+// toProtoPodcastChannel maps the iTunes feed extension onto a PodcastChannel, returning nil when absent.
+func toProtoPodcastChannel(itunes *ext.ITunesFeedExtension, resolver urlResolver) *pb.PodcastChannel {
+	if itunes == nil {
+		return nil
+	}
+
+	channel := &pb.PodcastChannel{
+		Author:     optionalString(cleanString(itunes.Author)),
+		Summary:    optionalString(cleanString(itunes.Summary)),
+		Image:      resolver.resolveOptional(itunes.Image),
+		Explicit:   parseITunesFlag(itunes.Explicit),
+		Categories: flattenITunesCategories(itunes.Categories),
+		Type:       optionalString(strings.ToLower(strings.TrimSpace(itunes.Type))),
+		Complete:   parseITunesFlag(itunes.Complete),
+		NewFeedUrl: resolver.resolveOptional(itunes.NewFeedURL),
+	}
+	if itunes.Owner != nil {
+		channel.OwnerName = optionalString(cleanString(itunes.Owner.Name))
+		channel.OwnerEmail = optionalString(strings.TrimSpace(itunes.Owner.Email))
+	}
+	return channel
+}
+
+// flattenITunesCategories lists category and subcategory names in document order without duplicates.
+func flattenITunesCategories(categories []*ext.ITunesCategory) []string {
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(categories))
+	for _, category := range categories {
+		for current := category; current != nil; current = current.Subcategory {
+			name := strings.TrimSpace(current.Text)
+			if name == "" || seen[name] {
+				continue
+			}
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// parseITunesFlag interprets the yes/true/explicit style flags used by iTunes tags.
+func parseITunesFlag(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "yes", "true", "explicit":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseITunesDuration converts "SS", "MM:SS" or "HH:MM:SS" durations into seconds.
+func parseITunesDuration(value string) (int64, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) > 3 {
+		return 0, false
+	}
+
+	var total int64
+	for _, part := range parts {
+		number, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || number < 0 {
+			return 0, false
+		}
+		total = total*60 + int64(number)
+	}
+	return total, true
+}
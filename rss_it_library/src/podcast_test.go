@@ -0,0 +1,146 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+)
+
+const testPodcastFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+  <channel>
+    <title>Test Podcast</title>
+    <link>https://podcast.example.com/</link>
+    <description>Episodes</description>
+    <itunes:author>Jane Host</itunes:author>
+    <itunes:summary>A show about &lt;b&gt;tests&lt;/b&gt;</itunes:summary>
+    <itunes:image href="https://podcast.example.com/cover.jpg"/>
+    <itunes:explicit>yes</itunes:explicit>
+    <itunes:type>Serial</itunes:type>
+    <itunes:owner>
+      <itunes:name>Jane Host</itunes:name>
+      <itunes:email>jane@example.com</itunes:email>
+    </itunes:owner>
+    <itunes:category text="Technology">
+      <itunes:category text="Software How-To"/>
+    </itunes:category>
+    <itunes:category text="Technology"/>
+    <item>
+      <title>Episode 1</title>
+      <link>https://podcast.example.com/1</link>
+      <enclosure url="https://cdn.example.com/ep1.mp3" type="audio/mpeg" length="12345"/>
+      <itunes:duration>1:02:03</itunes:duration>
+      <itunes:episode>1</itunes:episode>
+      <itunes:season>2</itunes:season>
+      <itunes:explicit>false</itunes:explicit>
+      <itunes:image href="https://podcast.example.com/ep1.jpg"/>
+      <itunes:episodeType>Full</itunes:episodeType>
+    </item>
+    <item>
+      <title>Plain post</title>
+      <enclosure url="https://cdn.example.com/file.pdf" type="application/pdf" length="0"/>
+    </item>
+  </channel>
+</rss>`
+
+func TestToProtoFeed_PodcastMetadata(t *testing.T) {
+	parsed, err := gofeed.NewParser().ParseString(testPodcastFeed)
+	if err != nil {
+		t.Fatalf("failed to parse fixture: %v", err)
+	}
+
+	feed := toProtoFeed("https://podcast.example.com/feed.xml", parsed, conversionOptions{})
+
+	channel := feed.GetPodcast()
+	if channel == nil {
+		t.Fatal("expected podcast channel metadata")
+	}
+	if channel.GetAuthor() != "Jane Host" || channel.GetOwnerEmail() != "jane@example.com" {
+		t.Errorf("unexpected author/owner: %v", channel)
+	}
+	if channel.GetSummary() != "A show about tests" {
+		t.Errorf("expected cleaned summary, got %q", channel.GetSummary())
+	}
+	if channel.GetImage() != "https://podcast.example.com/cover.jpg" {
+		t.Errorf("unexpected channel image %q", channel.GetImage())
+	}
+	if !channel.Explicit || channel.GetType() != "serial" {
+		t.Errorf("expected explicit serial show, got explicit=%v type=%q", channel.Explicit, channel.GetType())
+	}
+	if len(channel.Categories) != 2 || channel.Categories[0] != "Technology" || channel.Categories[1] != "Software How-To" {
+		t.Errorf("unexpected categories %v", channel.Categories)
+	}
+
+	if len(feed.Items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(feed.Items))
+	}
+
+	episode := feed.Items[0]
+	if len(episode.Enclosures) != 1 {
+		t.Fatalf("expected one enclosure, got %d", len(episode.Enclosures))
+	}
+	enclosure := episode.Enclosures[0]
+	if enclosure.Url != "https://cdn.example.com/ep1.mp3" || enclosure.GetMimeType() != "audio/mpeg" || enclosure.GetLength() != 12345 {
+		t.Errorf("unexpected enclosure %v", enclosure)
+	}
+
+	podcast := episode.GetPodcast()
+	if podcast == nil {
+		t.Fatal("expected podcast episode metadata")
+	}
+	if podcast.GetDuration() != "1:02:03" || podcast.GetDurationSeconds() != 3723 {
+		t.Errorf("unexpected duration %q (%d)", podcast.GetDuration(), podcast.GetDurationSeconds())
+	}
+	if podcast.GetEpisode() != 1 || podcast.GetSeason() != 2 {
+		t.Errorf("unexpected episode/season %d/%d", podcast.GetEpisode(), podcast.GetSeason())
+	}
+	if podcast.Explicit {
+		t.Error("expected episode not to be explicit")
+	}
+	if podcast.GetImage() != "https://podcast.example.com/ep1.jpg" || podcast.GetEpisodeType() != "full" {
+		t.Errorf("unexpected image/type %q/%q", podcast.GetImage(), podcast.GetEpisodeType())
+	}
+
+	plain := feed.Items[1]
+	if len(plain.Enclosures) != 1 || plain.Enclosures[0].Length != nil {
+		t.Errorf("expected enclosure without length, got %v", plain.Enclosures)
+	}
+}
+
+func TestToProtoFeed_NoPodcastMetadata(t *testing.T) {
+	parsed, err := gofeed.NewParser().ParseString(testRSSFeed)
+	if err != nil {
+		t.Fatalf("failed to parse fixture: %v", err)
+	}
+
+	feed := toProtoFeed("https://example.com/feed.xml", parsed, conversionOptions{})
+	if feed.Podcast != nil {
+		t.Errorf("expected no podcast metadata, got %v", feed.Podcast)
+	}
+	if feed.Items[0].Podcast != nil || len(feed.Items[0].Enclosures) != 0 {
+		t.Errorf("expected plain item, got %v", feed.Items[0])
+	}
+}
+
+func TestParseITunesDuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+		ok       bool
+	}{
+		{input: "3723", expected: 3723, ok: true},
+		{input: "62:03", expected: 3723, ok: true},
+		{input: "1:02:03", expected: 3723, ok: true},
+		{input: " 45 ", expected: 45, ok: true},
+		{input: "", ok: false},
+		{input: "1:2:3:4", ok: false},
+		{input: "abc", ok: false},
+	}
+
+	for _, tc := range tests {
+		got, ok := parseITunesDuration(tc.input)
+		if ok != tc.ok || got != tc.expected {
+			t.Errorf("parseITunesDuration(%q) = %d, %v; want %d, %v", tc.input, got, ok, tc.expected, tc.ok)
+		}
+	}
+}
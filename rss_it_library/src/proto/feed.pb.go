@@ -24,31 +24,61 @@ const (
 type ErrorKind int32
 
 const (
-	ErrorKind_ERROR_KIND_UNKNOWN       ErrorKind = 0
-	ErrorKind_ERROR_KIND_SERIALIZATION ErrorKind = 1
-	ErrorKind_ERROR_KIND_NETWORK       ErrorKind = 2
-	ErrorKind_ERROR_KIND_PARSING       ErrorKind = 3
-	ErrorKind_ERROR_KIND_VALIDATION    ErrorKind = 4
-	ErrorKind_ERROR_KIND_INTERNAL      ErrorKind = 5
+	ErrorKind_ERROR_KIND_UNKNOWN            ErrorKind = 0
+	ErrorKind_ERROR_KIND_SERIALIZATION      ErrorKind = 1
+	ErrorKind_ERROR_KIND_NETWORK            ErrorKind = 2
+	ErrorKind_ERROR_KIND_PARSING            ErrorKind = 3
+	ErrorKind_ERROR_KIND_VALIDATION         ErrorKind = 4
+	ErrorKind_ERROR_KIND_INTERNAL           ErrorKind = 5
+	ErrorKind_ERROR_KIND_CANCELLED          ErrorKind = 6
+	ErrorKind_ERROR_KIND_HTTP_STATUS        ErrorKind = 7
+	ErrorKind_ERROR_KIND_DNS                ErrorKind = 8
+	ErrorKind_ERROR_KIND_TLS                ErrorKind = 9
+	ErrorKind_ERROR_KIND_TIMEOUT            ErrorKind = 10
+	ErrorKind_ERROR_KIND_TOO_MANY_REDIRECTS ErrorKind = 11
+	ErrorKind_ERROR_KIND_RATE_LIMITED       ErrorKind = 12
+	ErrorKind_ERROR_KIND_NOT_A_FEED         ErrorKind = 13
+	ErrorKind_ERROR_KIND_BODY_TOO_LARGE     ErrorKind = 14
+	ErrorKind_ERROR_KIND_BLOCKED_BY_POLICY  ErrorKind = 15
 )
 
 // Enum value maps for ErrorKind.
 var (
 	ErrorKind_name = map[int32]string{
-		0: "ERROR_KIND_UNKNOWN",
-		1: "ERROR_KIND_SERIALIZATION",
-		2: "ERROR_KIND_NETWORK",
-		3: "ERROR_KIND_PARSING",
-		4: "ERROR_KIND_VALIDATION",
-		5: "ERROR_KIND_INTERNAL",
+		0:  "ERROR_KIND_UNKNOWN",
+		1:  "ERROR_KIND_SERIALIZATION",
+		2:  "ERROR_KIND_NETWORK",
+		3:  "ERROR_KIND_PARSING",
+		4:  "ERROR_KIND_VALIDATION",
+		5:  "ERROR_KIND_INTERNAL",
+		6:  "ERROR_KIND_CANCELLED",
+		7:  "ERROR_KIND_HTTP_STATUS",
+		8:  "ERROR_KIND_DNS",
+		9:  "ERROR_KIND_TLS",
+		10: "ERROR_KIND_TIMEOUT",
+		11: "ERROR_KIND_TOO_MANY_REDIRECTS",
+		12: "ERROR_KIND_RATE_LIMITED",
+		13: "ERROR_KIND_NOT_A_FEED",
+		14: "ERROR_KIND_BODY_TOO_LARGE",
+		15: "ERROR_KIND_BLOCKED_BY_POLICY",
 	}
 	ErrorKind_value = map[string]int32{
-		"ERROR_KIND_UNKNOWN":       0,
-		"ERROR_KIND_SERIALIZATION": 1,
-		"ERROR_KIND_NETWORK":       2,
-		"ERROR_KIND_PARSING":       3,
-		"ERROR_KIND_VALIDATION":    4,
-		"ERROR_KIND_INTERNAL":      5,
+		"ERROR_KIND_UNKNOWN":            0,
+		"ERROR_KIND_SERIALIZATION":      1,
+		"ERROR_KIND_NETWORK":            2,
+		"ERROR_KIND_PARSING":            3,
+		"ERROR_KIND_VALIDATION":         4,
+		"ERROR_KIND_INTERNAL":           5,
+		"ERROR_KIND_CANCELLED":          6,
+		"ERROR_KIND_HTTP_STATUS":        7,
+		"ERROR_KIND_DNS":                8,
+		"ERROR_KIND_TLS":                9,
+		"ERROR_KIND_TIMEOUT":            10,
+		"ERROR_KIND_TOO_MANY_REDIRECTS": 11,
+		"ERROR_KIND_RATE_LIMITED":       12,
+		"ERROR_KIND_NOT_A_FEED":         13,
+		"ERROR_KIND_BODY_TOO_LARGE":     14,
+		"ERROR_KIND_BLOCKED_BY_POLICY":  15,
 	}
 )
 
@@ -79,6 +109,309 @@ func (ErrorKind) EnumDescriptor() ([]byte, []int) {
 	return file_feed_proto_rawDescGZIP(), []int{0}
 }
 
+type HttpCacheStatus int32
+
+const (
+	HttpCacheStatus_HTTP_CACHE_STATUS_NONE        HttpCacheStatus = 0
+	HttpCacheStatus_HTTP_CACHE_STATUS_MISS        HttpCacheStatus = 1
+	HttpCacheStatus_HTTP_CACHE_STATUS_HIT         HttpCacheStatus = 2
+	HttpCacheStatus_HTTP_CACHE_STATUS_REVALIDATED HttpCacheStatus = 3
+)
+
+// Enum value maps for HttpCacheStatus.
+var (
+	HttpCacheStatus_name = map[int32]string{
+		0: "HTTP_CACHE_STATUS_NONE",
+		1: "HTTP_CACHE_STATUS_MISS",
+		2: "HTTP_CACHE_STATUS_HIT",
+		3: "HTTP_CACHE_STATUS_REVALIDATED",
+	}
+	HttpCacheStatus_value = map[string]int32{
+		"HTTP_CACHE_STATUS_NONE":        0,
+		"HTTP_CACHE_STATUS_MISS":        1,
+		"HTTP_CACHE_STATUS_HIT":         2,
+		"HTTP_CACHE_STATUS_REVALIDATED": 3,
+	}
+)
+
+func (x HttpCacheStatus) Enum() *HttpCacheStatus {
+	p := new(HttpCacheStatus)
+	*p = x
+	return p
+}
+
+func (x HttpCacheStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HttpCacheStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_feed_proto_enumTypes[1].Descriptor()
+}
+
+func (HttpCacheStatus) Type() protoreflect.EnumType {
+	return &file_feed_proto_enumTypes[1]
+}
+
+func (x HttpCacheStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HttpCacheStatus.Descriptor instead.
+func (HttpCacheStatus) EnumDescriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{1}
+}
+
+type TlsVersion int32
+
+const (
+	TlsVersion_TLS_VERSION_DEFAULT TlsVersion = 0
+	TlsVersion_TLS_VERSION_1_2     TlsVersion = 1
+	TlsVersion_TLS_VERSION_1_3     TlsVersion = 2
+)
+
+// Enum value maps for TlsVersion.
+var (
+	TlsVersion_name = map[int32]string{
+		0: "TLS_VERSION_DEFAULT",
+		1: "TLS_VERSION_1_2",
+		2: "TLS_VERSION_1_3",
+	}
+	TlsVersion_value = map[string]int32{
+		"TLS_VERSION_DEFAULT": 0,
+		"TLS_VERSION_1_2":     1,
+		"TLS_VERSION_1_3":     2,
+	}
+)
+
+func (x TlsVersion) Enum() *TlsVersion {
+	p := new(TlsVersion)
+	*p = x
+	return p
+}
+
+func (x TlsVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TlsVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_feed_proto_enumTypes[2].Descriptor()
+}
+
+func (TlsVersion) Type() protoreflect.EnumType {
+	return &file_feed_proto_enumTypes[2]
+}
+
+func (x TlsVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TlsVersion.Descriptor instead.
+func (TlsVersion) EnumDescriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{2}
+}
+
+type CacheAction int32
+
+const (
+	CacheAction_CACHE_ACTION_INSPECT CacheAction = 0
+	CacheAction_CACHE_ACTION_CLEAR   CacheAction = 1
+)
+
+// Enum value maps for CacheAction.
+var (
+	CacheAction_name = map[int32]string{
+		0: "CACHE_ACTION_INSPECT",
+		1: "CACHE_ACTION_CLEAR",
+	}
+	CacheAction_value = map[string]int32{
+		"CACHE_ACTION_INSPECT": 0,
+		"CACHE_ACTION_CLEAR":   1,
+	}
+)
+
+func (x CacheAction) Enum() *CacheAction {
+	p := new(CacheAction)
+	*p = x
+	return p
+}
+
+func (x CacheAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CacheAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_feed_proto_enumTypes[3].Descriptor()
+}
+
+func (CacheAction) Type() protoreflect.EnumType {
+	return &file_feed_proto_enumTypes[3]
+}
+
+func (x CacheAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CacheAction.Descriptor instead.
+func (CacheAction) EnumDescriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{3}
+}
+
+type DiscoverySource int32
+
+const (
+	DiscoverySource_DISCOVERY_SOURCE_UNKNOWN         DiscoverySource = 0
+	DiscoverySource_DISCOVERY_SOURCE_DIRECT          DiscoverySource = 1
+	DiscoverySource_DISCOVERY_SOURCE_LINK_TAG        DiscoverySource = 2
+	DiscoverySource_DISCOVERY_SOURCE_WELL_KNOWN_PATH DiscoverySource = 3
+)
+
+// Enum value maps for DiscoverySource.
+var (
+	DiscoverySource_name = map[int32]string{
+		0: "DISCOVERY_SOURCE_UNKNOWN",
+		1: "DISCOVERY_SOURCE_DIRECT",
+		2: "DISCOVERY_SOURCE_LINK_TAG",
+		3: "DISCOVERY_SOURCE_WELL_KNOWN_PATH",
+	}
+	DiscoverySource_value = map[string]int32{
+		"DISCOVERY_SOURCE_UNKNOWN":         0,
+		"DISCOVERY_SOURCE_DIRECT":          1,
+		"DISCOVERY_SOURCE_LINK_TAG":        2,
+		"DISCOVERY_SOURCE_WELL_KNOWN_PATH": 3,
+	}
+)
+
+func (x DiscoverySource) Enum() *DiscoverySource {
+	p := new(DiscoverySource)
+	*p = x
+	return p
+}
+
+func (x DiscoverySource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscoverySource) Descriptor() protoreflect.EnumDescriptor {
+	return file_feed_proto_enumTypes[4].Descriptor()
+}
+
+func (DiscoverySource) Type() protoreflect.EnumType {
+	return &file_feed_proto_enumTypes[4]
+}
+
+func (x DiscoverySource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscoverySource.Descriptor instead.
+func (DiscoverySource) EnumDescriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{4}
+}
+
+type IconSource int32
+
+const (
+	IconSource_ICON_SOURCE_UNKNOWN          IconSource = 0
+	IconSource_ICON_SOURCE_FEED             IconSource = 1
+	IconSource_ICON_SOURCE_LINK_ICON        IconSource = 2
+	IconSource_ICON_SOURCE_APPLE_TOUCH_ICON IconSource = 3
+	IconSource_ICON_SOURCE_FAVICON          IconSource = 4
+)
+
+// Enum value maps for IconSource.
+var (
+	IconSource_name = map[int32]string{
+		0: "ICON_SOURCE_UNKNOWN",
+		1: "ICON_SOURCE_FEED",
+		2: "ICON_SOURCE_LINK_ICON",
+		3: "ICON_SOURCE_APPLE_TOUCH_ICON",
+		4: "ICON_SOURCE_FAVICON",
+	}
+	IconSource_value = map[string]int32{
+		"ICON_SOURCE_UNKNOWN":          0,
+		"ICON_SOURCE_FEED":             1,
+		"ICON_SOURCE_LINK_ICON":        2,
+		"ICON_SOURCE_APPLE_TOUCH_ICON": 3,
+		"ICON_SOURCE_FAVICON":          4,
+	}
+)
+
+func (x IconSource) Enum() *IconSource {
+	p := new(IconSource)
+	*p = x
+	return p
+}
+
+func (x IconSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IconSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_feed_proto_enumTypes[5].Descriptor()
+}
+
+func (IconSource) Type() protoreflect.EnumType {
+	return &file_feed_proto_enumTypes[5]
+}
+
+func (x IconSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IconSource.Descriptor instead.
+func (IconSource) EnumDescriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{5}
+}
+
+type ContentFormat int32
+
+const (
+	ContentFormat_CONTENT_FORMAT_DEFAULT    ContentFormat = 0
+	ContentFormat_CONTENT_FORMAT_PLAIN_TEXT ContentFormat = 1
+	ContentFormat_CONTENT_FORMAT_HTML       ContentFormat = 2
+)
+
+// Enum value maps for ContentFormat.
+var (
+	ContentFormat_name = map[int32]string{
+		0: "CONTENT_FORMAT_DEFAULT",
+		1: "CONTENT_FORMAT_PLAIN_TEXT",
+		2: "CONTENT_FORMAT_HTML",
+	}
+	ContentFormat_value = map[string]int32{
+		"CONTENT_FORMAT_DEFAULT":    0,
+		"CONTENT_FORMAT_PLAIN_TEXT": 1,
+		"CONTENT_FORMAT_HTML":       2,
+	}
+)
+
+func (x ContentFormat) Enum() *ContentFormat {
+	p := new(ContentFormat)
+	*p = x
+	return p
+}
+
+func (x ContentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_feed_proto_enumTypes[6].Descriptor()
+}
+
+func (ContentFormat) Type() protoreflect.EnumType {
+	return &file_feed_proto_enumTypes[6]
+}
+
+func (x ContentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentFormat.Descriptor instead.
+func (ContentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{6}
+}
+
 type ParseFeedsStatus int32
 
 const (
@@ -112,11 +445,11 @@ func (x ParseFeedsStatus) String() string {
 }
 
 func (ParseFeedsStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_feed_proto_enumTypes[1].Descriptor()
+	return file_feed_proto_enumTypes[7].Descriptor()
 }
 
 func (ParseFeedsStatus) Type() protoreflect.EnumType {
-	return &file_feed_proto_enumTypes[1]
+	return &file_feed_proto_enumTypes[7]
 }
 
 func (x ParseFeedsStatus) Number() protoreflect.EnumNumber {
@@ -125,7 +458,68 @@ func (x ParseFeedsStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ParseFeedsStatus.Descriptor instead.
 func (ParseFeedsStatus) EnumDescriptor() ([]byte, []int) {
-	return file_feed_proto_rawDescGZIP(), []int{1}
+	return file_feed_proto_rawDescGZIP(), []int{7}
+}
+
+type ImageSource int32
+
+const (
+	ImageSource_IMAGE_SOURCE_NONE       ImageSource = 0
+	ImageSource_IMAGE_SOURCE_FEED       ImageSource = 1
+	ImageSource_IMAGE_SOURCE_PODCAST    ImageSource = 2
+	ImageSource_IMAGE_SOURCE_MEDIA      ImageSource = 3
+	ImageSource_IMAGE_SOURCE_ENCLOSURE  ImageSource = 4
+	ImageSource_IMAGE_SOURCE_CONTENT    ImageSource = 5
+	ImageSource_IMAGE_SOURCE_OPEN_GRAPH ImageSource = 6
+)
+
+// Enum value maps for ImageSource.
+var (
+	ImageSource_name = map[int32]string{
+		0: "IMAGE_SOURCE_NONE",
+		1: "IMAGE_SOURCE_FEED",
+		2: "IMAGE_SOURCE_PODCAST",
+		3: "IMAGE_SOURCE_MEDIA",
+		4: "IMAGE_SOURCE_ENCLOSURE",
+		5: "IMAGE_SOURCE_CONTENT",
+		6: "IMAGE_SOURCE_OPEN_GRAPH",
+	}
+	ImageSource_value = map[string]int32{
+		"IMAGE_SOURCE_NONE":       0,
+		"IMAGE_SOURCE_FEED":       1,
+		"IMAGE_SOURCE_PODCAST":    2,
+		"IMAGE_SOURCE_MEDIA":      3,
+		"IMAGE_SOURCE_ENCLOSURE":  4,
+		"IMAGE_SOURCE_CONTENT":    5,
+		"IMAGE_SOURCE_OPEN_GRAPH": 6,
+	}
+)
+
+func (x ImageSource) Enum() *ImageSource {
+	p := new(ImageSource)
+	*p = x
+	return p
+}
+
+func (x ImageSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImageSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_feed_proto_enumTypes[8].Descriptor()
+}
+
+func (ImageSource) Type() protoreflect.EnumType {
+	return &file_feed_proto_enumTypes[8]
+}
+
+func (x ImageSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImageSource.Descriptor instead.
+func (ImageSource) EnumDescriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{8}
 }
 
 type ErrorDetail struct {
@@ -133,6 +527,13 @@ type ErrorDetail struct {
 	Kind          ErrorKind              `protobuf:"varint,1,opt,name=kind,proto3,enum=proto.ErrorKind" json:"kind,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Diagnostics   *FetchDiagnostics      `protobuf:"bytes,4,opt,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	HttpStatus    int32                  `protobuf:"varint,5,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	RetryAfterMs  int64                  `protobuf:"varint,6,opt,name=retry_after_ms,json=retryAfterMs,proto3" json:"retry_after_ms,omitempty"`
+	Retryable     bool                   `protobuf:"varint,7,opt,name=retryable,proto3" json:"retryable,omitempty"`
+	CanonicalUrl  string                 `protobuf:"bytes,8,opt,name=canonical_url,json=canonicalUrl,proto3" json:"canonical_url,omitempty"`
+	MergedUrls    []string               `protobuf:"bytes,9,rep,name=merged_urls,json=mergedUrls,proto3" json:"merged_urls,omitempty"`
+	InputIndex    *int32                 `protobuf:"varint,10,opt,name=input_index,json=inputIndex,proto3,oneof" json:"input_index,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -174,42 +575,2974 @@ func (x *ErrorDetail) GetKind() ErrorKind {
 	return ErrorKind_ERROR_KIND_UNKNOWN
 }
 
-func (x *ErrorDetail) GetMessage() string {
+func (x *ErrorDetail) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ErrorDetail) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ErrorDetail) GetDiagnostics() *FetchDiagnostics {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *ErrorDetail) GetHttpStatus() int32 {
+	if x != nil {
+		return x.HttpStatus
+	}
+	return 0
+}
+
+func (x *ErrorDetail) GetRetryAfterMs() int64 {
+	if x != nil {
+		return x.RetryAfterMs
+	}
+	return 0
+}
+
+func (x *ErrorDetail) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+func (x *ErrorDetail) GetCanonicalUrl() string {
+	if x != nil {
+		return x.CanonicalUrl
+	}
+	return ""
+}
+
+func (x *ErrorDetail) GetMergedUrls() []string {
+	if x != nil {
+		return x.MergedUrls
+	}
+	return nil
+}
+
+func (x *ErrorDetail) GetInputIndex() int32 {
+	if x != nil && x.InputIndex != nil {
+		return *x.InputIndex
+	}
+	return 0
+}
+
+type FetchDiagnostics struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StatusCode       int32                  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	FinalUrl         string                 `protobuf:"bytes,2,opt,name=final_url,json=finalUrl,proto3" json:"final_url,omitempty"`
+	ContentType      string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	BytesDownloaded  int64                  `protobuf:"varint,4,opt,name=bytes_downloaded,json=bytesDownloaded,proto3" json:"bytes_downloaded,omitempty"`
+	DnsMs            int64                  `protobuf:"varint,5,opt,name=dns_ms,json=dnsMs,proto3" json:"dns_ms,omitempty"`
+	ConnectMs        int64                  `protobuf:"varint,6,opt,name=connect_ms,json=connectMs,proto3" json:"connect_ms,omitempty"`
+	TlsMs            int64                  `protobuf:"varint,7,opt,name=tls_ms,json=tlsMs,proto3" json:"tls_ms,omitempty"`
+	TtfbMs           int64                  `protobuf:"varint,8,opt,name=ttfb_ms,json=ttfbMs,proto3" json:"ttfb_ms,omitempty"`
+	ParseMs          int64                  `protobuf:"varint,9,opt,name=parse_ms,json=parseMs,proto3" json:"parse_ms,omitempty"`
+	TotalMs          int64                  `protobuf:"varint,10,opt,name=total_ms,json=totalMs,proto3" json:"total_ms,omitempty"`
+	ConnectionReused bool                   `protobuf:"varint,11,opt,name=connection_reused,json=connectionReused,proto3" json:"connection_reused,omitempty"`
+	RetryCount       int32                  `protobuf:"varint,12,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	HttpCache        HttpCacheStatus        `protobuf:"varint,13,opt,name=http_cache,json=httpCache,proto3,enum=proto.HttpCacheStatus" json:"http_cache,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FetchDiagnostics) Reset() {
+	*x = FetchDiagnostics{}
+	mi := &file_feed_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchDiagnostics) ProtoMessage() {}
+
+func (x *FetchDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchDiagnostics.ProtoReflect.Descriptor instead.
+func (*FetchDiagnostics) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FetchDiagnostics) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *FetchDiagnostics) GetFinalUrl() string {
+	if x != nil {
+		return x.FinalUrl
+	}
+	return ""
+}
+
+func (x *FetchDiagnostics) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *FetchDiagnostics) GetBytesDownloaded() int64 {
+	if x != nil {
+		return x.BytesDownloaded
+	}
+	return 0
+}
+
+func (x *FetchDiagnostics) GetDnsMs() int64 {
+	if x != nil {
+		return x.DnsMs
+	}
+	return 0
+}
+
+func (x *FetchDiagnostics) GetConnectMs() int64 {
+	if x != nil {
+		return x.ConnectMs
+	}
+	return 0
+}
+
+func (x *FetchDiagnostics) GetTlsMs() int64 {
+	if x != nil {
+		return x.TlsMs
+	}
+	return 0
+}
+
+func (x *FetchDiagnostics) GetTtfbMs() int64 {
+	if x != nil {
+		return x.TtfbMs
+	}
+	return 0
+}
+
+func (x *FetchDiagnostics) GetParseMs() int64 {
+	if x != nil {
+		return x.ParseMs
+	}
+	return 0
+}
+
+func (x *FetchDiagnostics) GetTotalMs() int64 {
+	if x != nil {
+		return x.TotalMs
+	}
+	return 0
+}
+
+func (x *FetchDiagnostics) GetConnectionReused() bool {
+	if x != nil {
+		return x.ConnectionReused
+	}
+	return false
+}
+
+func (x *FetchDiagnostics) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *FetchDiagnostics) GetHttpCache() HttpCacheStatus {
+	if x != nil {
+		return x.HttpCache
+	}
+	return HttpCacheStatus_HTTP_CACHE_STATUS_NONE
+}
+
+type ClientConfig struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	UserAgent               string                 `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	TimeoutMs               int64                  `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	ConnectTimeoutMs        int64                  `protobuf:"varint,3,opt,name=connect_timeout_ms,json=connectTimeoutMs,proto3" json:"connect_timeout_ms,omitempty"`
+	TlsHandshakeTimeoutMs   int64                  `protobuf:"varint,4,opt,name=tls_handshake_timeout_ms,json=tlsHandshakeTimeoutMs,proto3" json:"tls_handshake_timeout_ms,omitempty"`
+	ResponseHeaderTimeoutMs int64                  `protobuf:"varint,5,opt,name=response_header_timeout_ms,json=responseHeaderTimeoutMs,proto3" json:"response_header_timeout_ms,omitempty"`
+	IdleConnTimeoutMs       int64                  `protobuf:"varint,6,opt,name=idle_conn_timeout_ms,json=idleConnTimeoutMs,proto3" json:"idle_conn_timeout_ms,omitempty"`
+	MaxIdleConnsPerHost     int32                  `protobuf:"varint,7,opt,name=max_idle_conns_per_host,json=maxIdleConnsPerHost,proto3" json:"max_idle_conns_per_host,omitempty"`
+	MaxRedirects            *int32                 `protobuf:"varint,8,opt,name=max_redirects,json=maxRedirects,proto3,oneof" json:"max_redirects,omitempty"`
+	ProxyUrl                string                 `protobuf:"bytes,9,opt,name=proxy_url,json=proxyUrl,proto3" json:"proxy_url,omitempty"`
+	InsecureSkipVerify      bool                   `protobuf:"varint,10,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	MinTlsVersion           TlsVersion             `protobuf:"varint,11,opt,name=min_tls_version,json=minTlsVersion,proto3,enum=proto.TlsVersion" json:"min_tls_version,omitempty"`
+	CacheTtlMs              int64                  `protobuf:"varint,12,opt,name=cache_ttl_ms,json=cacheTtlMs,proto3" json:"cache_ttl_ms,omitempty"`
+	CacheMaxBytes           int64                  `protobuf:"varint,13,opt,name=cache_max_bytes,json=cacheMaxBytes,proto3" json:"cache_max_bytes,omitempty"`
+	HttpCacheDir            string                 `protobuf:"bytes,14,opt,name=http_cache_dir,json=httpCacheDir,proto3" json:"http_cache_dir,omitempty"`
+	HttpCacheMaxBytes       int64                  `protobuf:"varint,15,opt,name=http_cache_max_bytes,json=httpCacheMaxBytes,proto3" json:"http_cache_max_bytes,omitempty"`
+	NetworkPolicy           *NetworkPolicy         `protobuf:"bytes,16,opt,name=network_policy,json=networkPolicy,proto3" json:"network_policy,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ClientConfig) Reset() {
+	*x = ClientConfig{}
+	mi := &file_feed_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientConfig) ProtoMessage() {}
+
+func (x *ClientConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientConfig.ProtoReflect.Descriptor instead.
+func (*ClientConfig) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClientConfig) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ClientConfig) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *ClientConfig) GetConnectTimeoutMs() int64 {
+	if x != nil {
+		return x.ConnectTimeoutMs
+	}
+	return 0
+}
+
+func (x *ClientConfig) GetTlsHandshakeTimeoutMs() int64 {
+	if x != nil {
+		return x.TlsHandshakeTimeoutMs
+	}
+	return 0
+}
+
+func (x *ClientConfig) GetResponseHeaderTimeoutMs() int64 {
+	if x != nil {
+		return x.ResponseHeaderTimeoutMs
+	}
+	return 0
+}
+
+func (x *ClientConfig) GetIdleConnTimeoutMs() int64 {
+	if x != nil {
+		return x.IdleConnTimeoutMs
+	}
+	return 0
+}
+
+func (x *ClientConfig) GetMaxIdleConnsPerHost() int32 {
+	if x != nil {
+		return x.MaxIdleConnsPerHost
+	}
+	return 0
+}
+
+func (x *ClientConfig) GetMaxRedirects() int32 {
+	if x != nil && x.MaxRedirects != nil {
+		return *x.MaxRedirects
+	}
+	return 0
+}
+
+func (x *ClientConfig) GetProxyUrl() string {
+	if x != nil {
+		return x.ProxyUrl
+	}
+	return ""
+}
+
+func (x *ClientConfig) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *ClientConfig) GetMinTlsVersion() TlsVersion {
+	if x != nil {
+		return x.MinTlsVersion
+	}
+	return TlsVersion_TLS_VERSION_DEFAULT
+}
+
+func (x *ClientConfig) GetCacheTtlMs() int64 {
+	if x != nil {
+		return x.CacheTtlMs
+	}
+	return 0
+}
+
+func (x *ClientConfig) GetCacheMaxBytes() int64 {
+	if x != nil {
+		return x.CacheMaxBytes
+	}
+	return 0
+}
+
+func (x *ClientConfig) GetHttpCacheDir() string {
+	if x != nil {
+		return x.HttpCacheDir
+	}
+	return ""
+}
+
+func (x *ClientConfig) GetHttpCacheMaxBytes() int64 {
+	if x != nil {
+		return x.HttpCacheMaxBytes
+	}
+	return 0
+}
+
+func (x *ClientConfig) GetNetworkPolicy() *NetworkPolicy {
+	if x != nil {
+		return x.NetworkPolicy
+	}
+	return nil
+}
+
+type NetworkPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AllowedPorts  []int32                `protobuf:"varint,2,rep,packed,name=allowed_ports,json=allowedPorts,proto3" json:"allowed_ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkPolicy) Reset() {
+	*x = NetworkPolicy{}
+	mi := &file_feed_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkPolicy) ProtoMessage() {}
+
+func (x *NetworkPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkPolicy.ProtoReflect.Descriptor instead.
+func (*NetworkPolicy) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NetworkPolicy) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *NetworkPolicy) GetAllowedPorts() []int32 {
+	if x != nil {
+		return x.AllowedPorts
+	}
+	return nil
+}
+
+type ConfigureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         *ErrorDetail           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureResponse) Reset() {
+	*x = ConfigureResponse{}
+	mi := &file_feed_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureResponse) ProtoMessage() {}
+
+func (x *ConfigureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureResponse.ProtoReflect.Descriptor instead.
+func (*ConfigureResponse) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConfigureResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfigureResponse) GetError() *ErrorDetail {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type CacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        CacheAction            `protobuf:"varint,1,opt,name=action,proto3,enum=proto.CacheAction" json:"action,omitempty"`
+	Urls          []string               `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheRequest) Reset() {
+	*x = CacheRequest{}
+	mi := &file_feed_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheRequest) ProtoMessage() {}
+
+func (x *CacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheRequest.ProtoReflect.Descriptor instead.
+func (*CacheRequest) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CacheRequest) GetAction() CacheAction {
+	if x != nil {
+		return x.Action
+	}
+	return CacheAction_CACHE_ACTION_INSPECT
+}
+
+func (x *CacheRequest) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+type CacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*CacheEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	TotalBytes    int64                  `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	MaxBytes      int64                  `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	TtlMs         int64                  `protobuf:"varint,4,opt,name=ttl_ms,json=ttlMs,proto3" json:"ttl_ms,omitempty"`
+	Cleared       int32                  `protobuf:"varint,5,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	Error         *ErrorDetail           `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheResponse) Reset() {
+	*x = CacheResponse{}
+	mi := &file_feed_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheResponse) ProtoMessage() {}
+
+func (x *CacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheResponse.ProtoReflect.Descriptor instead.
+func (*CacheResponse) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CacheResponse) GetEntries() []*CacheEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *CacheResponse) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *CacheResponse) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *CacheResponse) GetTtlMs() int64 {
+	if x != nil {
+		return x.TtlMs
+	}
+	return 0
+}
+
+func (x *CacheResponse) GetCleared() int32 {
+	if x != nil {
+		return x.Cleared
+	}
+	return 0
+}
+
+func (x *CacheResponse) GetError() *ErrorDetail {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type CacheEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	AgeMs         int64                  `protobuf:"varint,3,opt,name=age_ms,json=ageMs,proto3" json:"age_ms,omitempty"`
+	ExpiresInMs   int64                  `protobuf:"varint,4,opt,name=expires_in_ms,json=expiresInMs,proto3" json:"expires_in_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheEntry) Reset() {
+	*x = CacheEntry{}
+	mi := &file_feed_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheEntry) ProtoMessage() {}
+
+func (x *CacheEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheEntry.ProtoReflect.Descriptor instead.
+func (*CacheEntry) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CacheEntry) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CacheEntry) GetAgeMs() int64 {
+	if x != nil {
+		return x.AgeMs
+	}
+	return 0
+}
+
+func (x *CacheEntry) GetExpiresInMs() int64 {
+	if x != nil {
+		return x.ExpiresInMs
+	}
+	return 0
+}
+
+type CancelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	mi := &file_feed_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type CancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cancelled     bool                   `protobuf:"varint,1,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	mi := &file_feed_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelResponse) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+type ValidateFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TimeoutMs     int64                  `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateFeedRequest) Reset() {
+	*x = ValidateFeedRequest{}
+	mi := &file_feed_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateFeedRequest) ProtoMessage() {}
+
+func (x *ValidateFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateFeedRequest.ProtoReflect.Descriptor instead.
+func (*ValidateFeedRequest) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ValidateFeedRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ValidateFeedRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ValidateFeedRequest) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type ValidateFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Error         *ErrorDetail           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	CanonicalUrl  string                 `protobuf:"bytes,3,opt,name=canonical_url,json=canonicalUrl,proto3" json:"canonical_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateFeedResponse) Reset() {
+	*x = ValidateFeedResponse{}
+	mi := &file_feed_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateFeedResponse) ProtoMessage() {}
+
+func (x *ValidateFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateFeedResponse.ProtoReflect.Descriptor instead.
+func (*ValidateFeedResponse) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValidateFeedResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateFeedResponse) GetError() *ErrorDetail {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ValidateFeedResponse) GetCanonicalUrl() string {
+	if x != nil {
+		return x.CanonicalUrl
+	}
+	return ""
+}
+
+type NormalizeUrlsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Urls                []string               `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	StripTrackingParams bool                   `protobuf:"varint,2,opt,name=strip_tracking_params,json=stripTrackingParams,proto3" json:"strip_tracking_params,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NormalizeUrlsRequest) Reset() {
+	*x = NormalizeUrlsRequest{}
+	mi := &file_feed_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeUrlsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeUrlsRequest) ProtoMessage() {}
+
+func (x *NormalizeUrlsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeUrlsRequest.ProtoReflect.Descriptor instead.
+func (*NormalizeUrlsRequest) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NormalizeUrlsRequest) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *NormalizeUrlsRequest) GetStripTrackingParams() bool {
+	if x != nil {
+		return x.StripTrackingParams
+	}
+	return false
+}
+
+type NormalizeUrlsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Urls          []*NormalizedUrl       `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	Error         *ErrorDetail           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NormalizeUrlsResponse) Reset() {
+	*x = NormalizeUrlsResponse{}
+	mi := &file_feed_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeUrlsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeUrlsResponse) ProtoMessage() {}
+
+func (x *NormalizeUrlsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeUrlsResponse.ProtoReflect.Descriptor instead.
+func (*NormalizeUrlsResponse) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NormalizeUrlsResponse) GetUrls() []*NormalizedUrl {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *NormalizeUrlsResponse) GetError() *ErrorDetail {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type NormalizedUrl struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Canonical     string                 `protobuf:"bytes,2,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	Error         *ErrorDetail           `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NormalizedUrl) Reset() {
+	*x = NormalizedUrl{}
+	mi := &file_feed_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizedUrl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizedUrl) ProtoMessage() {}
+
+func (x *NormalizedUrl) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizedUrl.ProtoReflect.Descriptor instead.
+func (*NormalizedUrl) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NormalizedUrl) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *NormalizedUrl) GetCanonical() string {
+	if x != nil {
+		return x.Canonical
+	}
+	return ""
+}
+
+func (x *NormalizedUrl) GetError() *ErrorDetail {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DiscoverFeedsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscoverFeedsRequest) Reset() {
+	*x = DiscoverFeedsRequest{}
+	mi := &file_feed_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscoverFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverFeedsRequest) ProtoMessage() {}
+
+func (x *DiscoverFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverFeedsRequest.ProtoReflect.Descriptor instead.
+func (*DiscoverFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DiscoverFeedsRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DiscoverFeedsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type DiscoverFeedsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feeds         []*DiscoveredFeed      `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	Error         *ErrorDetail           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscoverFeedsResponse) Reset() {
+	*x = DiscoverFeedsResponse{}
+	mi := &file_feed_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscoverFeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverFeedsResponse) ProtoMessage() {}
+
+func (x *DiscoverFeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverFeedsResponse.ProtoReflect.Descriptor instead.
+func (*DiscoverFeedsResponse) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DiscoverFeedsResponse) GetFeeds() []*DiscoveredFeed {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+func (x *DiscoverFeedsResponse) GetError() *ErrorDetail {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DiscoveredFeed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Source        DiscoverySource        `protobuf:"varint,4,opt,name=source,proto3,enum=proto.DiscoverySource" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscoveredFeed) Reset() {
+	*x = DiscoveredFeed{}
+	mi := &file_feed_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscoveredFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoveredFeed) ProtoMessage() {}
+
+func (x *DiscoveredFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoveredFeed.ProtoReflect.Descriptor instead.
+func (*DiscoveredFeed) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DiscoveredFeed) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DiscoveredFeed) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DiscoveredFeed) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *DiscoveredFeed) GetSource() DiscoverySource {
+	if x != nil {
+		return x.Source
+	}
+	return DiscoverySource_DISCOVERY_SOURCE_UNKNOWN
+}
+
+type ResolveIconRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	IncludeData   bool                   `protobuf:"varint,3,opt,name=include_data,json=includeData,proto3" json:"include_data,omitempty"`
+	MaxBytes      int64                  `protobuf:"varint,4,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveIconRequest) Reset() {
+	*x = ResolveIconRequest{}
+	mi := &file_feed_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveIconRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveIconRequest) ProtoMessage() {}
+
+func (x *ResolveIconRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveIconRequest.ProtoReflect.Descriptor instead.
+func (*ResolveIconRequest) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ResolveIconRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ResolveIconRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ResolveIconRequest) GetIncludeData() bool {
+	if x != nil {
+		return x.IncludeData
+	}
+	return false
+}
+
+func (x *ResolveIconRequest) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+type ResolveIconResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Icon          *FeedIcon              `protobuf:"bytes,1,opt,name=icon,proto3" json:"icon,omitempty"`
+	Candidates    []*FeedIcon            `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Error         *ErrorDetail           `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveIconResponse) Reset() {
+	*x = ResolveIconResponse{}
+	mi := &file_feed_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveIconResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveIconResponse) ProtoMessage() {}
+
+func (x *ResolveIconResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveIconResponse.ProtoReflect.Descriptor instead.
+func (*ResolveIconResponse) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ResolveIconResponse) GetIcon() *FeedIcon {
+	if x != nil {
+		return x.Icon
+	}
+	return nil
+}
+
+func (x *ResolveIconResponse) GetCandidates() []*FeedIcon {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *ResolveIconResponse) GetError() *ErrorDetail {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type FeedIcon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	MimeType      *string                `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
+	Width         int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Source        IconSource             `protobuf:"varint,5,opt,name=source,proto3,enum=proto.IconSource" json:"source,omitempty"`
+	Data          []byte                 `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	ByteSize      int64                  `protobuf:"varint,7,opt,name=byte_size,json=byteSize,proto3" json:"byte_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedIcon) Reset() {
+	*x = FeedIcon{}
+	mi := &file_feed_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedIcon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedIcon) ProtoMessage() {}
+
+func (x *FeedIcon) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedIcon.ProtoReflect.Descriptor instead.
+func (*FeedIcon) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FeedIcon) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FeedIcon) GetMimeType() string {
+	if x != nil && x.MimeType != nil {
+		return *x.MimeType
+	}
+	return ""
+}
+
+func (x *FeedIcon) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *FeedIcon) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *FeedIcon) GetSource() IconSource {
+	if x != nil {
+		return x.Source
+	}
+	return IconSource_ICON_SOURCE_UNKNOWN
+}
+
+func (x *FeedIcon) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FeedIcon) GetByteSize() int64 {
+	if x != nil {
+		return x.ByteSize
+	}
+	return 0
+}
+
+type ImportOpmlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Opml          []byte                 `protobuf:"bytes,1,opt,name=opml,proto3" json:"opml,omitempty"`
+	Validate      bool                   `protobuf:"varint,2,opt,name=validate,proto3" json:"validate,omitempty"`
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOpmlRequest) Reset() {
+	*x = ImportOpmlRequest{}
+	mi := &file_feed_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOpmlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOpmlRequest) ProtoMessage() {}
+
+func (x *ImportOpmlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOpmlRequest.ProtoReflect.Descriptor instead.
+func (*ImportOpmlRequest) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportOpmlRequest) GetOpml() []byte {
+	if x != nil {
+		return x.Opml
+	}
+	return nil
+}
+
+func (x *ImportOpmlRequest) GetValidate() bool {
+	if x != nil {
+		return x.Validate
+	}
+	return false
+}
+
+func (x *ImportOpmlRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type ImportOpmlResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Subscriptions []*OpmlSubscription    `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Error         *ErrorDetail           `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOpmlResponse) Reset() {
+	*x = ImportOpmlResponse{}
+	mi := &file_feed_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOpmlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOpmlResponse) ProtoMessage() {}
+
+func (x *ImportOpmlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOpmlResponse.ProtoReflect.Descriptor instead.
+func (*ImportOpmlResponse) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportOpmlResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportOpmlResponse) GetSubscriptions() []*OpmlSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *ImportOpmlResponse) GetError() *ErrorDetail {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type OpmlSubscription struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	XmlUrl          string                 `protobuf:"bytes,2,opt,name=xml_url,json=xmlUrl,proto3" json:"xml_url,omitempty"`
+	HtmlUrl         *string                `protobuf:"bytes,3,opt,name=html_url,json=htmlUrl,proto3,oneof" json:"html_url,omitempty"`
+	Categories      []string               `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	Valid           *bool                  `protobuf:"varint,5,opt,name=valid,proto3,oneof" json:"valid,omitempty"`
+	ValidationError *ErrorDetail           `protobuf:"bytes,6,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OpmlSubscription) Reset() {
+	*x = OpmlSubscription{}
+	mi := &file_feed_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpmlSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpmlSubscription) ProtoMessage() {}
+
+func (x *OpmlSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpmlSubscription.ProtoReflect.Descriptor instead.
+func (*OpmlSubscription) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OpmlSubscription) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OpmlSubscription) GetXmlUrl() string {
+	if x != nil {
+		return x.XmlUrl
+	}
+	return ""
+}
+
+func (x *OpmlSubscription) GetHtmlUrl() string {
+	if x != nil && x.HtmlUrl != nil {
+		return *x.HtmlUrl
+	}
+	return ""
+}
+
+func (x *OpmlSubscription) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *OpmlSubscription) GetValid() bool {
+	if x != nil && x.Valid != nil {
+		return *x.Valid
+	}
+	return false
+}
+
+func (x *OpmlSubscription) GetValidationError() *ErrorDetail {
+	if x != nil {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type ExportOpmlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Feeds         []*Feed                `protobuf:"bytes,2,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOpmlRequest) Reset() {
+	*x = ExportOpmlRequest{}
+	mi := &file_feed_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOpmlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOpmlRequest) ProtoMessage() {}
+
+func (x *ExportOpmlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOpmlRequest.ProtoReflect.Descriptor instead.
+func (*ExportOpmlRequest) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportOpmlRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ExportOpmlRequest) GetFeeds() []*Feed {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+type ExportOpmlResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Opml          []byte                 `protobuf:"bytes,1,opt,name=opml,proto3" json:"opml,omitempty"`
+	Error         *ErrorDetail           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOpmlResponse) Reset() {
+	*x = ExportOpmlResponse{}
+	mi := &file_feed_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOpmlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOpmlResponse) ProtoMessage() {}
+
+func (x *ExportOpmlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOpmlResponse.ProtoReflect.Descriptor instead.
+func (*ExportOpmlResponse) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportOpmlResponse) GetOpml() []byte {
+	if x != nil {
+		return x.Opml
+	}
+	return nil
+}
+
+func (x *ExportOpmlResponse) GetError() *ErrorDetail {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ParseFeedsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Urls                []string               `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	Validators          []*FeedValidators      `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+	RequestId           string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TimeoutMs           int64                  `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	FeedTimeoutMs       int64                  `protobuf:"varint,5,opt,name=feed_timeout_ms,json=feedTimeoutMs,proto3" json:"feed_timeout_ms,omitempty"`
+	MaxConcurrency      int32                  `protobuf:"varint,6,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	Retry               *RetryPolicy           `protobuf:"bytes,7,opt,name=retry,proto3" json:"retry,omitempty"`
+	ContentFormat       ContentFormat          `protobuf:"varint,8,opt,name=content_format,json=contentFormat,proto3,enum=proto.ContentFormat" json:"content_format,omitempty"`
+	FetchOgImage        bool                   `protobuf:"varint,9,opt,name=fetch_og_image,json=fetchOgImage,proto3" json:"fetch_og_image,omitempty"`
+	BypassCache         bool                   `protobuf:"varint,10,opt,name=bypass_cache,json=bypassCache,proto3" json:"bypass_cache,omitempty"`
+	RefreshCache        bool                   `protobuf:"varint,11,opt,name=refresh_cache,json=refreshCache,proto3" json:"refresh_cache,omitempty"`
+	Limits              *FeedLimits            `protobuf:"bytes,12,opt,name=limits,proto3" json:"limits,omitempty"`
+	StripTrackingParams bool                   `protobuf:"varint,13,opt,name=strip_tracking_params,json=stripTrackingParams,proto3" json:"strip_tracking_params,omitempty"`
+	PreserveOrder       bool                   `protobuf:"varint,14,opt,name=preserve_order,json=preserveOrder,proto3" json:"preserve_order,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ParseFeedsRequest) Reset() {
+	*x = ParseFeedsRequest{}
+	mi := &file_feed_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseFeedsRequest) ProtoMessage() {}
+
+func (x *ParseFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseFeedsRequest.ProtoReflect.Descriptor instead.
+func (*ParseFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ParseFeedsRequest) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *ParseFeedsRequest) GetValidators() []*FeedValidators {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *ParseFeedsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ParseFeedsRequest) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *ParseFeedsRequest) GetFeedTimeoutMs() int64 {
+	if x != nil {
+		return x.FeedTimeoutMs
+	}
+	return 0
+}
+
+func (x *ParseFeedsRequest) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *ParseFeedsRequest) GetRetry() *RetryPolicy {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *ParseFeedsRequest) GetContentFormat() ContentFormat {
+	if x != nil {
+		return x.ContentFormat
+	}
+	return ContentFormat_CONTENT_FORMAT_DEFAULT
+}
+
+func (x *ParseFeedsRequest) GetFetchOgImage() bool {
+	if x != nil {
+		return x.FetchOgImage
+	}
+	return false
+}
+
+func (x *ParseFeedsRequest) GetBypassCache() bool {
+	if x != nil {
+		return x.BypassCache
+	}
+	return false
+}
+
+func (x *ParseFeedsRequest) GetRefreshCache() bool {
+	if x != nil {
+		return x.RefreshCache
+	}
+	return false
+}
+
+func (x *ParseFeedsRequest) GetLimits() *FeedLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *ParseFeedsRequest) GetStripTrackingParams() bool {
+	if x != nil {
+		return x.StripTrackingParams
+	}
+	return false
+}
+
+func (x *ParseFeedsRequest) GetPreserveOrder() bool {
+	if x != nil {
+		return x.PreserveOrder
+	}
+	return false
+}
+
+type FeedLimits struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	MaxBodyBytes         int64                  `protobuf:"varint,1,opt,name=max_body_bytes,json=maxBodyBytes,proto3" json:"max_body_bytes,omitempty"`
+	MaxItems             int32                  `protobuf:"varint,2,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	MaxTitleLength       int32                  `protobuf:"varint,3,opt,name=max_title_length,json=maxTitleLength,proto3" json:"max_title_length,omitempty"`
+	MaxDescriptionLength int32                  `protobuf:"varint,4,opt,name=max_description_length,json=maxDescriptionLength,proto3" json:"max_description_length,omitempty"`
+	MaxContentLength     int32                  `protobuf:"varint,5,opt,name=max_content_length,json=maxContentLength,proto3" json:"max_content_length,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *FeedLimits) Reset() {
+	*x = FeedLimits{}
+	mi := &file_feed_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedLimits) ProtoMessage() {}
+
+func (x *FeedLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedLimits.ProtoReflect.Descriptor instead.
+func (*FeedLimits) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FeedLimits) GetMaxBodyBytes() int64 {
+	if x != nil {
+		return x.MaxBodyBytes
+	}
+	return 0
+}
+
+func (x *FeedLimits) GetMaxItems() int32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+func (x *FeedLimits) GetMaxTitleLength() int32 {
+	if x != nil {
+		return x.MaxTitleLength
+	}
+	return 0
+}
+
+func (x *FeedLimits) GetMaxDescriptionLength() int32 {
+	if x != nil {
+		return x.MaxDescriptionLength
+	}
+	return 0
+}
+
+func (x *FeedLimits) GetMaxContentLength() int32 {
+	if x != nil {
+		return x.MaxContentLength
+	}
+	return 0
+}
+
+type RetryPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxAttempts   int32                  `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	BaseDelayMs   int64                  `protobuf:"varint,2,opt,name=base_delay_ms,json=baseDelayMs,proto3" json:"base_delay_ms,omitempty"`
+	MaxDelayMs    int64                  `protobuf:"varint,3,opt,name=max_delay_ms,json=maxDelayMs,proto3" json:"max_delay_ms,omitempty"`
+	Jitter        *float64               `protobuf:"fixed64,4,opt,name=jitter,proto3,oneof" json:"jitter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_feed_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBaseDelayMs() int64 {
+	if x != nil {
+		return x.BaseDelayMs
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxDelayMs() int64 {
+	if x != nil {
+		return x.MaxDelayMs
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetJitter() float64 {
+	if x != nil && x.Jitter != nil {
+		return *x.Jitter
+	}
+	return 0
+}
+
+type FeedValidators struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Etag          *string                `protobuf:"bytes,2,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
+	LastModified  *string                `protobuf:"bytes,3,opt,name=last_modified,json=lastModified,proto3,oneof" json:"last_modified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedValidators) Reset() {
+	*x = FeedValidators{}
+	mi := &file_feed_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedValidators) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedValidators) ProtoMessage() {}
+
+func (x *FeedValidators) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedValidators.ProtoReflect.Descriptor instead.
+func (*FeedValidators) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FeedValidators) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FeedValidators) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
+func (x *FeedValidators) GetLastModified() string {
+	if x != nil && x.LastModified != nil {
+		return *x.LastModified
+	}
+	return ""
+}
+
+type ParseFeedsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ParseFeedsStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=proto.ParseFeedsStatus" json:"status,omitempty"`
+	Feeds         []*Feed                `protobuf:"bytes,2,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	Errors        []*ErrorDetail         `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	FatalError    *ErrorDetail           `protobuf:"bytes,4,opt,name=fatal_error,json=fatalError,proto3" json:"fatal_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseFeedsResponse) Reset() {
+	*x = ParseFeedsResponse{}
+	mi := &file_feed_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseFeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseFeedsResponse) ProtoMessage() {}
+
+func (x *ParseFeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseFeedsResponse.ProtoReflect.Descriptor instead.
+func (*ParseFeedsResponse) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ParseFeedsResponse) GetStatus() ParseFeedsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ParseFeedsStatus_SUCCESS
+}
+
+func (x *ParseFeedsResponse) GetFeeds() []*Feed {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+func (x *ParseFeedsResponse) GetErrors() []*ErrorDetail {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ParseFeedsResponse) GetFatalError() *ErrorDetail {
+	if x != nil {
+		return x.FatalError
+	}
+	return nil
+}
+
+type ParseStreamEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*ParseStreamEvent_Feed
+	//	*ParseStreamEvent_Error
+	//	*ParseStreamEvent_Summary
+	Event         isParseStreamEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseStreamEvent) Reset() {
+	*x = ParseStreamEvent{}
+	mi := &file_feed_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseStreamEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseStreamEvent) ProtoMessage() {}
+
+func (x *ParseStreamEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseStreamEvent.ProtoReflect.Descriptor instead.
+func (*ParseStreamEvent) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ParseStreamEvent) GetEvent() isParseStreamEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ParseStreamEvent) GetFeed() *Feed {
+	if x != nil {
+		if x, ok := x.Event.(*ParseStreamEvent_Feed); ok {
+			return x.Feed
+		}
+	}
+	return nil
+}
+
+func (x *ParseStreamEvent) GetError() *ErrorDetail {
+	if x != nil {
+		if x, ok := x.Event.(*ParseStreamEvent_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *ParseStreamEvent) GetSummary() *ParseStreamSummary {
+	if x != nil {
+		if x, ok := x.Event.(*ParseStreamEvent_Summary); ok {
+			return x.Summary
+		}
+	}
+	return nil
+}
+
+type isParseStreamEvent_Event interface {
+	isParseStreamEvent_Event()
+}
+
+type ParseStreamEvent_Feed struct {
+	Feed *Feed `protobuf:"bytes,1,opt,name=feed,proto3,oneof"`
+}
+
+type ParseStreamEvent_Error struct {
+	Error *ErrorDetail `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+type ParseStreamEvent_Summary struct {
+	Summary *ParseStreamSummary `protobuf:"bytes,3,opt,name=summary,proto3,oneof"`
+}
+
+func (*ParseStreamEvent_Feed) isParseStreamEvent_Event() {}
+
+func (*ParseStreamEvent_Error) isParseStreamEvent_Event() {}
+
+func (*ParseStreamEvent_Summary) isParseStreamEvent_Event() {}
+
+type ParseStreamSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ParseFeedsStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=proto.ParseFeedsStatus" json:"status,omitempty"`
+	FeedCount     int32                  `protobuf:"varint,2,opt,name=feed_count,json=feedCount,proto3" json:"feed_count,omitempty"`
+	ErrorCount    int32                  `protobuf:"varint,3,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	FatalError    *ErrorDetail           `protobuf:"bytes,4,opt,name=fatal_error,json=fatalError,proto3" json:"fatal_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseStreamSummary) Reset() {
+	*x = ParseStreamSummary{}
+	mi := &file_feed_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseStreamSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseStreamSummary) ProtoMessage() {}
+
+func (x *ParseStreamSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseStreamSummary.ProtoReflect.Descriptor instead.
+func (*ParseStreamSummary) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ParseStreamSummary) GetStatus() ParseFeedsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ParseFeedsStatus_SUCCESS
+}
+
+func (x *ParseStreamSummary) GetFeedCount() int32 {
+	if x != nil {
+		return x.FeedCount
+	}
+	return 0
+}
+
+func (x *ParseStreamSummary) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *ParseStreamSummary) GetFatalError() *ErrorDetail {
+	if x != nil {
+		return x.FatalError
+	}
+	return nil
+}
+
+type Feed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Image         *string                `protobuf:"bytes,5,opt,name=image,proto3,oneof" json:"image,omitempty"`
+	Items         []*FeedItem            `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	Etag          *string                `protobuf:"bytes,7,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
+	LastModified  *string                `protobuf:"bytes,8,opt,name=last_modified,json=lastModified,proto3,oneof" json:"last_modified,omitempty"`
+	NotModified   bool                   `protobuf:"varint,9,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+	Link          *string                `protobuf:"bytes,10,opt,name=link,proto3,oneof" json:"link,omitempty"`
+	Podcast       *PodcastChannel        `protobuf:"bytes,11,opt,name=podcast,proto3" json:"podcast,omitempty"`
+	Diagnostics   *FetchDiagnostics      `protobuf:"bytes,12,opt,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	JsonFeed      *JsonFeedChannel       `protobuf:"bytes,13,opt,name=json_feed,json=jsonFeed,proto3" json:"json_feed,omitempty"`
+	Cached        bool                   `protobuf:"varint,14,opt,name=cached,proto3" json:"cached,omitempty"`
+	CacheAgeMs    int64                  `protobuf:"varint,15,opt,name=cache_age_ms,json=cacheAgeMs,proto3" json:"cache_age_ms,omitempty"`
+	Truncated     bool                   `protobuf:"varint,16,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	CanonicalUrl  string                 `protobuf:"bytes,17,opt,name=canonical_url,json=canonicalUrl,proto3" json:"canonical_url,omitempty"`
+	MergedUrls    []string               `protobuf:"bytes,18,rep,name=merged_urls,json=mergedUrls,proto3" json:"merged_urls,omitempty"`
+	InputIndex    int32                  `protobuf:"varint,19,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feed) Reset() {
+	*x = Feed{}
+	mi := &file_feed_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed) ProtoMessage() {}
+
+func (x *Feed) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed.ProtoReflect.Descriptor instead.
+func (*Feed) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Feed) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Feed) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Feed) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Feed) GetImage() string {
+	if x != nil && x.Image != nil {
+		return *x.Image
+	}
+	return ""
+}
+
+func (x *Feed) GetItems() []*FeedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Feed) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
+func (x *Feed) GetLastModified() string {
+	if x != nil && x.LastModified != nil {
+		return *x.LastModified
+	}
+	return ""
+}
+
+func (x *Feed) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+func (x *Feed) GetLink() string {
+	if x != nil && x.Link != nil {
+		return *x.Link
+	}
+	return ""
+}
+
+func (x *Feed) GetPodcast() *PodcastChannel {
+	if x != nil {
+		return x.Podcast
+	}
+	return nil
+}
+
+func (x *Feed) GetDiagnostics() *FetchDiagnostics {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *Feed) GetJsonFeed() *JsonFeedChannel {
+	if x != nil {
+		return x.JsonFeed
+	}
+	return nil
+}
+
+func (x *Feed) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *Feed) GetCacheAgeMs() int64 {
+	if x != nil {
+		return x.CacheAgeMs
+	}
+	return 0
+}
+
+func (x *Feed) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *Feed) GetCanonicalUrl() string {
+	if x != nil {
+		return x.CanonicalUrl
+	}
+	return ""
+}
+
+func (x *Feed) GetMergedUrls() []string {
+	if x != nil {
+		return x.MergedUrls
+	}
+	return nil
+}
+
+func (x *Feed) GetInputIndex() int32 {
+	if x != nil {
+		return x.InputIndex
+	}
+	return 0
+}
+
+type JsonFeedChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HomePageUrl   *string                `protobuf:"bytes,1,opt,name=home_page_url,json=homePageUrl,proto3,oneof" json:"home_page_url,omitempty"`
+	FeedUrl       *string                `protobuf:"bytes,2,opt,name=feed_url,json=feedUrl,proto3,oneof" json:"feed_url,omitempty"`
+	Icon          *string                `protobuf:"bytes,3,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	Favicon       *string                `protobuf:"bytes,4,opt,name=favicon,proto3,oneof" json:"favicon,omitempty"`
+	NextUrl       *string                `protobuf:"bytes,5,opt,name=next_url,json=nextUrl,proto3,oneof" json:"next_url,omitempty"`
+	Language      *string                `protobuf:"bytes,6,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	Expired       bool                   `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+	Authors       []*Author              `protobuf:"bytes,8,rep,name=authors,proto3" json:"authors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JsonFeedChannel) Reset() {
+	*x = JsonFeedChannel{}
+	mi := &file_feed_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonFeedChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonFeedChannel) ProtoMessage() {}
+
+func (x *JsonFeedChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonFeedChannel.ProtoReflect.Descriptor instead.
+func (*JsonFeedChannel) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *JsonFeedChannel) GetHomePageUrl() string {
+	if x != nil && x.HomePageUrl != nil {
+		return *x.HomePageUrl
+	}
+	return ""
+}
+
+func (x *JsonFeedChannel) GetFeedUrl() string {
+	if x != nil && x.FeedUrl != nil {
+		return *x.FeedUrl
+	}
+	return ""
+}
+
+func (x *JsonFeedChannel) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *JsonFeedChannel) GetFavicon() string {
+	if x != nil && x.Favicon != nil {
+		return *x.Favicon
+	}
+	return ""
+}
+
+func (x *JsonFeedChannel) GetNextUrl() string {
+	if x != nil && x.NextUrl != nil {
+		return *x.NextUrl
+	}
+	return ""
+}
+
+func (x *JsonFeedChannel) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *JsonFeedChannel) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *JsonFeedChannel) GetAuthors() []*Author {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+type Author struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           *string                `protobuf:"bytes,2,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	Avatar        *string                `protobuf:"bytes,3,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	mi := &file_feed_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Author) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Author) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *Author) GetAvatar() string {
+	if x != nil && x.Avatar != nil {
+		return *x.Avatar
+	}
+	return ""
+}
+
+type PodcastChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *string                `protobuf:"bytes,1,opt,name=author,proto3,oneof" json:"author,omitempty"`
+	Summary       *string                `protobuf:"bytes,2,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+	Image         *string                `protobuf:"bytes,3,opt,name=image,proto3,oneof" json:"image,omitempty"`
+	Explicit      bool                   `protobuf:"varint,4,opt,name=explicit,proto3" json:"explicit,omitempty"`
+	Categories    []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	Type          *string                `protobuf:"bytes,6,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	OwnerName     *string                `protobuf:"bytes,7,opt,name=owner_name,json=ownerName,proto3,oneof" json:"owner_name,omitempty"`
+	OwnerEmail    *string                `protobuf:"bytes,8,opt,name=owner_email,json=ownerEmail,proto3,oneof" json:"owner_email,omitempty"`
+	Complete      bool                   `protobuf:"varint,9,opt,name=complete,proto3" json:"complete,omitempty"`
+	NewFeedUrl    *string                `protobuf:"bytes,10,opt,name=new_feed_url,json=newFeedUrl,proto3,oneof" json:"new_feed_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PodcastChannel) Reset() {
+	*x = PodcastChannel{}
+	mi := &file_feed_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodcastChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodcastChannel) ProtoMessage() {}
+
+func (x *PodcastChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodcastChannel.ProtoReflect.Descriptor instead.
+func (*PodcastChannel) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PodcastChannel) GetAuthor() string {
+	if x != nil && x.Author != nil {
+		return *x.Author
+	}
+	return ""
+}
+
+func (x *PodcastChannel) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *PodcastChannel) GetImage() string {
+	if x != nil && x.Image != nil {
+		return *x.Image
+	}
+	return ""
+}
+
+func (x *PodcastChannel) GetExplicit() bool {
+	if x != nil {
+		return x.Explicit
+	}
+	return false
+}
+
+func (x *PodcastChannel) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *PodcastChannel) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *PodcastChannel) GetOwnerName() string {
+	if x != nil && x.OwnerName != nil {
+		return *x.OwnerName
+	}
+	return ""
+}
+
+func (x *PodcastChannel) GetOwnerEmail() string {
+	if x != nil && x.OwnerEmail != nil {
+		return *x.OwnerEmail
+	}
+	return ""
+}
+
+func (x *PodcastChannel) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *PodcastChannel) GetNewFeedUrl() string {
+	if x != nil && x.NewFeedUrl != nil {
+		return *x.NewFeedUrl
+	}
+	return ""
+}
+
+type FeedItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Link          *string                `protobuf:"bytes,3,opt,name=link,proto3,oneof" json:"link,omitempty"`
+	Image         *string                `protobuf:"bytes,4,opt,name=image,proto3,oneof" json:"image,omitempty"`
+	Published     *string                `protobuf:"bytes,5,opt,name=published,proto3,oneof" json:"published,omitempty"`
+	Enclosures    []*Enclosure           `protobuf:"bytes,6,rep,name=enclosures,proto3" json:"enclosures,omitempty"`
+	Podcast       *PodcastEpisode        `protobuf:"bytes,7,opt,name=podcast,proto3" json:"podcast,omitempty"`
+	Id            string                 `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
+	Guid          *string                `protobuf:"bytes,9,opt,name=guid,proto3,oneof" json:"guid,omitempty"`
+	ContentHash   string                 `protobuf:"bytes,10,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	JsonFeed      *JsonFeedItem          `protobuf:"bytes,11,opt,name=json_feed,json=jsonFeed,proto3" json:"json_feed,omitempty"`
+	Content       *string                `protobuf:"bytes,12,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	ImageSource   ImageSource            `protobuf:"varint,13,opt,name=image_source,json=imageSource,proto3,enum=proto.ImageSource" json:"image_source,omitempty"`
+	Truncated     bool                   `protobuf:"varint,14,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedItem) Reset() {
+	*x = FeedItem{}
+	mi := &file_feed_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedItem) ProtoMessage() {}
+
+func (x *FeedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedItem.ProtoReflect.Descriptor instead.
+func (*FeedItem) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FeedItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FeedItem) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *FeedItem) GetLink() string {
+	if x != nil && x.Link != nil {
+		return *x.Link
+	}
+	return ""
+}
+
+func (x *FeedItem) GetImage() string {
+	if x != nil && x.Image != nil {
+		return *x.Image
+	}
+	return ""
+}
+
+func (x *FeedItem) GetPublished() string {
+	if x != nil && x.Published != nil {
+		return *x.Published
+	}
+	return ""
+}
+
+func (x *FeedItem) GetEnclosures() []*Enclosure {
+	if x != nil {
+		return x.Enclosures
+	}
+	return nil
+}
+
+func (x *FeedItem) GetPodcast() *PodcastEpisode {
+	if x != nil {
+		return x.Podcast
+	}
+	return nil
+}
+
+func (x *FeedItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FeedItem) GetGuid() string {
+	if x != nil && x.Guid != nil {
+		return *x.Guid
+	}
+	return ""
+}
+
+func (x *FeedItem) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *FeedItem) GetJsonFeed() *JsonFeedItem {
+	if x != nil {
+		return x.JsonFeed
+	}
+	return nil
+}
+
+func (x *FeedItem) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *FeedItem) GetImageSource() ImageSource {
 	if x != nil {
-		return x.Message
+		return x.ImageSource
 	}
-	return ""
+	return ImageSource_IMAGE_SOURCE_NONE
 }
 
-func (x *ErrorDetail) GetUrl() string {
+func (x *FeedItem) GetTruncated() bool {
 	if x != nil {
-		return x.Url
+		return x.Truncated
 	}
-	return ""
+	return false
 }
 
-type ValidateFeedRequest struct {
+type JsonFeedItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ContentHtml   *string                `protobuf:"bytes,1,opt,name=content_html,json=contentHtml,proto3,oneof" json:"content_html,omitempty"`
+	ContentText   *string                `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3,oneof" json:"content_text,omitempty"`
+	Summary       *string                `protobuf:"bytes,3,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+	BannerImage   *string                `protobuf:"bytes,4,opt,name=banner_image,json=bannerImage,proto3,oneof" json:"banner_image,omitempty"`
+	ExternalUrl   *string                `protobuf:"bytes,5,opt,name=external_url,json=externalUrl,proto3,oneof" json:"external_url,omitempty"`
+	DateModified  *string                `protobuf:"bytes,6,opt,name=date_modified,json=dateModified,proto3,oneof" json:"date_modified,omitempty"`
+	Authors       []*Author              `protobuf:"bytes,7,rep,name=authors,proto3" json:"authors,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,9,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Language      *string                `protobuf:"bytes,10,opt,name=language,proto3,oneof" json:"language,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateFeedRequest) Reset() {
-	*x = ValidateFeedRequest{}
-	mi := &file_feed_proto_msgTypes[1]
+func (x *JsonFeedItem) Reset() {
+	*x = JsonFeedItem{}
+	mi := &file_feed_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateFeedRequest) String() string {
+func (x *JsonFeedItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateFeedRequest) ProtoMessage() {}
+func (*JsonFeedItem) ProtoMessage() {}
 
-func (x *ValidateFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_proto_msgTypes[1]
+func (x *JsonFeedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -220,139 +3553,107 @@ func (x *ValidateFeedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateFeedRequest.ProtoReflect.Descriptor instead.
-func (*ValidateFeedRequest) Descriptor() ([]byte, []int) {
-	return file_feed_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use JsonFeedItem.ProtoReflect.Descriptor instead.
+func (*JsonFeedItem) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ValidateFeedRequest) GetUrl() string {
-	if x != nil {
-		return x.Url
+func (x *JsonFeedItem) GetContentHtml() string {
+	if x != nil && x.ContentHtml != nil {
+		return *x.ContentHtml
 	}
 	return ""
 }
 
-type ValidateFeedResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	Error         *ErrorDetail           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *JsonFeedItem) GetContentText() string {
+	if x != nil && x.ContentText != nil {
+		return *x.ContentText
+	}
+	return ""
 }
 
-func (x *ValidateFeedResponse) Reset() {
-	*x = ValidateFeedResponse{}
-	mi := &file_feed_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *JsonFeedItem) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
 }
 
-func (x *ValidateFeedResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JsonFeedItem) GetBannerImage() string {
+	if x != nil && x.BannerImage != nil {
+		return *x.BannerImage
+	}
+	return ""
 }
 
-func (*ValidateFeedResponse) ProtoMessage() {}
-
-func (x *ValidateFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JsonFeedItem) GetExternalUrl() string {
+	if x != nil && x.ExternalUrl != nil {
+		return *x.ExternalUrl
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ValidateFeedResponse.ProtoReflect.Descriptor instead.
-func (*ValidateFeedResponse) Descriptor() ([]byte, []int) {
-	return file_feed_proto_rawDescGZIP(), []int{2}
+func (x *JsonFeedItem) GetDateModified() string {
+	if x != nil && x.DateModified != nil {
+		return *x.DateModified
+	}
+	return ""
 }
 
-func (x *ValidateFeedResponse) GetValid() bool {
+func (x *JsonFeedItem) GetAuthors() []*Author {
 	if x != nil {
-		return x.Valid
+		return x.Authors
 	}
-	return false
+	return nil
 }
 
-func (x *ValidateFeedResponse) GetError() *ErrorDetail {
+func (x *JsonFeedItem) GetTags() []string {
 	if x != nil {
-		return x.Error
+		return x.Tags
 	}
 	return nil
 }
 
-type ParseFeedsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Urls          []string               `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ParseFeedsRequest) Reset() {
-	*x = ParseFeedsRequest{}
-	mi := &file_feed_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ParseFeedsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ParseFeedsRequest) ProtoMessage() {}
-
-func (x *ParseFeedsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_proto_msgTypes[3]
+func (x *JsonFeedItem) GetAttachments() []*Attachment {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Attachments
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ParseFeedsRequest.ProtoReflect.Descriptor instead.
-func (*ParseFeedsRequest) Descriptor() ([]byte, []int) {
-	return file_feed_proto_rawDescGZIP(), []int{3}
+	return nil
 }
 
-func (x *ParseFeedsRequest) GetUrls() []string {
-	if x != nil {
-		return x.Urls
+func (x *JsonFeedItem) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
 	}
-	return nil
+	return ""
 }
 
-type ParseFeedsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        ParseFeedsStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=proto.ParseFeedsStatus" json:"status,omitempty"`
-	Feeds         []*Feed                `protobuf:"bytes,2,rep,name=feeds,proto3" json:"feeds,omitempty"`
-	Errors        []*ErrorDetail         `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
-	FatalError    *ErrorDetail           `protobuf:"bytes,4,opt,name=fatal_error,json=fatalError,proto3" json:"fatal_error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Attachment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Url             string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	MimeType        string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Title           *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	SizeInBytes     *int64                 `protobuf:"varint,4,opt,name=size_in_bytes,json=sizeInBytes,proto3,oneof" json:"size_in_bytes,omitempty"`
+	DurationSeconds *int64                 `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3,oneof" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ParseFeedsResponse) Reset() {
-	*x = ParseFeedsResponse{}
-	mi := &file_feed_proto_msgTypes[4]
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_feed_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ParseFeedsResponse) String() string {
+func (x *Attachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParseFeedsResponse) ProtoMessage() {}
+func (*Attachment) ProtoMessage() {}
 
-func (x *ParseFeedsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_proto_msgTypes[4]
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,65 +3664,70 @@ func (x *ParseFeedsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParseFeedsResponse.ProtoReflect.Descriptor instead.
-func (*ParseFeedsResponse) Descriptor() ([]byte, []int) {
-	return file_feed_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ParseFeedsResponse) GetStatus() ParseFeedsStatus {
+func (x *Attachment) GetUrl() string {
 	if x != nil {
-		return x.Status
+		return x.Url
 	}
-	return ParseFeedsStatus_SUCCESS
+	return ""
 }
 
-func (x *ParseFeedsResponse) GetFeeds() []*Feed {
+func (x *Attachment) GetMimeType() string {
 	if x != nil {
-		return x.Feeds
+		return x.MimeType
 	}
-	return nil
+	return ""
 }
 
-func (x *ParseFeedsResponse) GetErrors() []*ErrorDetail {
-	if x != nil {
-		return x.Errors
+func (x *Attachment) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *ParseFeedsResponse) GetFatalError() *ErrorDetail {
-	if x != nil {
-		return x.FatalError
+func (x *Attachment) GetSizeInBytes() int64 {
+	if x != nil && x.SizeInBytes != nil {
+		return *x.SizeInBytes
 	}
-	return nil
+	return 0
 }
 
-type Feed struct {
+func (x *Attachment) GetDurationSeconds() int64 {
+	if x != nil && x.DurationSeconds != nil {
+		return *x.DurationSeconds
+	}
+	return 0
+}
+
+type Enclosure struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Image         *string                `protobuf:"bytes,5,opt,name=image,proto3,oneof" json:"image,omitempty"`
-	Items         []*FeedItem            `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	MimeType      *string                `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3,oneof" json:"mime_type,omitempty"`
+	Length        *int64                 `protobuf:"varint,3,opt,name=length,proto3,oneof" json:"length,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Feed) Reset() {
-	*x = Feed{}
-	mi := &file_feed_proto_msgTypes[5]
+func (x *Enclosure) Reset() {
+	*x = Enclosure{}
+	mi := &file_feed_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Feed) String() string {
+func (x *Enclosure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Feed) ProtoMessage() {}
+func (*Enclosure) ProtoMessage() {}
 
-func (x *Feed) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_proto_msgTypes[5]
+func (x *Enclosure) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,72 +3738,60 @@ func (x *Feed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Feed.ProtoReflect.Descriptor instead.
-func (*Feed) Descriptor() ([]byte, []int) {
-	return file_feed_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Enclosure.ProtoReflect.Descriptor instead.
+func (*Enclosure) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *Feed) GetUrl() string {
+func (x *Enclosure) GetUrl() string {
 	if x != nil {
 		return x.Url
 	}
 	return ""
 }
 
-func (x *Feed) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *Feed) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *Feed) GetImage() string {
-	if x != nil && x.Image != nil {
-		return *x.Image
+func (x *Enclosure) GetMimeType() string {
+	if x != nil && x.MimeType != nil {
+		return *x.MimeType
 	}
 	return ""
 }
 
-func (x *Feed) GetItems() []*FeedItem {
-	if x != nil {
-		return x.Items
+func (x *Enclosure) GetLength() int64 {
+	if x != nil && x.Length != nil {
+		return *x.Length
 	}
-	return nil
+	return 0
 }
 
-type FeedItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Link          *string                `protobuf:"bytes,3,opt,name=link,proto3,oneof" json:"link,omitempty"`
-	Image         *string                `protobuf:"bytes,4,opt,name=image,proto3,oneof" json:"image,omitempty"`
-	Published     *string                `protobuf:"bytes,5,opt,name=published,proto3,oneof" json:"published,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PodcastEpisode struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Duration        *string                `protobuf:"bytes,1,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	DurationSeconds *int64                 `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3,oneof" json:"duration_seconds,omitempty"`
+	Episode         *int32                 `protobuf:"varint,3,opt,name=episode,proto3,oneof" json:"episode,omitempty"`
+	Season          *int32                 `protobuf:"varint,4,opt,name=season,proto3,oneof" json:"season,omitempty"`
+	Explicit        bool                   `protobuf:"varint,5,opt,name=explicit,proto3" json:"explicit,omitempty"`
+	Image           *string                `protobuf:"bytes,6,opt,name=image,proto3,oneof" json:"image,omitempty"`
+	EpisodeType     *string                `protobuf:"bytes,7,opt,name=episode_type,json=episodeType,proto3,oneof" json:"episode_type,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *FeedItem) Reset() {
-	*x = FeedItem{}
-	mi := &file_feed_proto_msgTypes[6]
+func (x *PodcastEpisode) Reset() {
+	*x = PodcastEpisode{}
+	mi := &file_feed_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FeedItem) String() string {
+func (x *PodcastEpisode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeedItem) ProtoMessage() {}
+func (*PodcastEpisode) ProtoMessage() {}
 
-func (x *FeedItem) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_proto_msgTypes[6]
+func (x *PodcastEpisode) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,42 +3802,56 @@ func (x *FeedItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeedItem.ProtoReflect.Descriptor instead.
-func (*FeedItem) Descriptor() ([]byte, []int) {
-	return file_feed_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PodcastEpisode.ProtoReflect.Descriptor instead.
+func (*PodcastEpisode) Descriptor() ([]byte, []int) {
+	return file_feed_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *FeedItem) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *PodcastEpisode) GetDuration() string {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
-func (x *FeedItem) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *PodcastEpisode) GetDurationSeconds() int64 {
+	if x != nil && x.DurationSeconds != nil {
+		return *x.DurationSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *FeedItem) GetLink() string {
-	if x != nil && x.Link != nil {
-		return *x.Link
+func (x *PodcastEpisode) GetEpisode() int32 {
+	if x != nil && x.Episode != nil {
+		return *x.Episode
 	}
-	return ""
+	return 0
 }
 
-func (x *FeedItem) GetImage() string {
+func (x *PodcastEpisode) GetSeason() int32 {
+	if x != nil && x.Season != nil {
+		return *x.Season
+	}
+	return 0
+}
+
+func (x *PodcastEpisode) GetExplicit() bool {
+	if x != nil {
+		return x.Explicit
+	}
+	return false
+}
+
+func (x *PodcastEpisode) GetImage() string {
 	if x != nil && x.Image != nil {
 		return *x.Image
 	}
 	return ""
 }
 
-func (x *FeedItem) GetPublished() string {
-	if x != nil && x.Published != nil {
-		return *x.Published
+func (x *PodcastEpisode) GetEpisodeType() string {
+	if x != nil && x.EpisodeType != nil {
+		return *x.EpisodeType
 	}
 	return ""
 }
@@ -553,54 +3861,444 @@ var File_feed_proto protoreflect.FileDescriptor
 const file_feed_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"feed.proto\x12\x05proto\"_\n" +
+	"feed.proto\x12\x05proto\"\xfb\x02\n" +
 	"\vErrorDetail\x12$\n" +
 	"\x04kind\x18\x01 \x01(\x0e2\x10.proto.ErrorKindR\x04kind\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03url\"'\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x129\n" +
+	"\vdiagnostics\x18\x04 \x01(\v2\x17.proto.FetchDiagnosticsR\vdiagnostics\x12\x1f\n" +
+	"\vhttp_status\x18\x05 \x01(\x05R\n" +
+	"httpStatus\x12$\n" +
+	"\x0eretry_after_ms\x18\x06 \x01(\x03R\fretryAfterMs\x12\x1c\n" +
+	"\tretryable\x18\a \x01(\bR\tretryable\x12#\n" +
+	"\rcanonical_url\x18\b \x01(\tR\fcanonicalUrl\x12\x1f\n" +
+	"\vmerged_urls\x18\t \x03(\tR\n" +
+	"mergedUrls\x12$\n" +
+	"\vinput_index\x18\n" +
+	" \x01(\x05H\x00R\n" +
+	"inputIndex\x88\x01\x01B\x0e\n" +
+	"\f_input_index\"\xbf\x03\n" +
+	"\x10FetchDiagnostics\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12\x1b\n" +
+	"\tfinal_url\x18\x02 \x01(\tR\bfinalUrl\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12)\n" +
+	"\x10bytes_downloaded\x18\x04 \x01(\x03R\x0fbytesDownloaded\x12\x15\n" +
+	"\x06dns_ms\x18\x05 \x01(\x03R\x05dnsMs\x12\x1d\n" +
+	"\n" +
+	"connect_ms\x18\x06 \x01(\x03R\tconnectMs\x12\x15\n" +
+	"\x06tls_ms\x18\a \x01(\x03R\x05tlsMs\x12\x17\n" +
+	"\attfb_ms\x18\b \x01(\x03R\x06ttfbMs\x12\x19\n" +
+	"\bparse_ms\x18\t \x01(\x03R\aparseMs\x12\x19\n" +
+	"\btotal_ms\x18\n" +
+	" \x01(\x03R\atotalMs\x12+\n" +
+	"\x11connection_reused\x18\v \x01(\bR\x10connectionReused\x12\x1f\n" +
+	"\vretry_count\x18\f \x01(\x05R\n" +
+	"retryCount\x125\n" +
+	"\n" +
+	"http_cache\x18\r \x01(\x0e2\x16.proto.HttpCacheStatusR\thttpCache\"\xfb\x05\n" +
+	"\fClientConfig\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x01 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x02 \x01(\x03R\ttimeoutMs\x12,\n" +
+	"\x12connect_timeout_ms\x18\x03 \x01(\x03R\x10connectTimeoutMs\x127\n" +
+	"\x18tls_handshake_timeout_ms\x18\x04 \x01(\x03R\x15tlsHandshakeTimeoutMs\x12;\n" +
+	"\x1aresponse_header_timeout_ms\x18\x05 \x01(\x03R\x17responseHeaderTimeoutMs\x12/\n" +
+	"\x14idle_conn_timeout_ms\x18\x06 \x01(\x03R\x11idleConnTimeoutMs\x124\n" +
+	"\x17max_idle_conns_per_host\x18\a \x01(\x05R\x13maxIdleConnsPerHost\x12(\n" +
+	"\rmax_redirects\x18\b \x01(\x05H\x00R\fmaxRedirects\x88\x01\x01\x12\x1b\n" +
+	"\tproxy_url\x18\t \x01(\tR\bproxyUrl\x120\n" +
+	"\x14insecure_skip_verify\x18\n" +
+	" \x01(\bR\x12insecureSkipVerify\x129\n" +
+	"\x0fmin_tls_version\x18\v \x01(\x0e2\x11.proto.TlsVersionR\rminTlsVersion\x12 \n" +
+	"\fcache_ttl_ms\x18\f \x01(\x03R\n" +
+	"cacheTtlMs\x12&\n" +
+	"\x0fcache_max_bytes\x18\r \x01(\x03R\rcacheMaxBytes\x12$\n" +
+	"\x0ehttp_cache_dir\x18\x0e \x01(\tR\fhttpCacheDir\x12/\n" +
+	"\x14http_cache_max_bytes\x18\x0f \x01(\x03R\x11httpCacheMaxBytes\x12;\n" +
+	"\x0enetwork_policy\x18\x10 \x01(\v2\x14.proto.NetworkPolicyR\rnetworkPolicyB\x10\n" +
+	"\x0e_max_redirects\"N\n" +
+	"\rNetworkPolicy\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
+	"\rallowed_ports\x18\x02 \x03(\x05R\fallowedPorts\"W\n" +
+	"\x11ConfigureResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\x12.proto.ErrorDetailR\x05error\"N\n" +
+	"\fCacheRequest\x12*\n" +
+	"\x06action\x18\x01 \x01(\x0e2\x12.proto.CacheActionR\x06action\x12\x12\n" +
+	"\x04urls\x18\x02 \x03(\tR\x04urls\"\xd5\x01\n" +
+	"\rCacheResponse\x12+\n" +
+	"\aentries\x18\x01 \x03(\v2\x11.proto.CacheEntryR\aentries\x12\x1f\n" +
+	"\vtotal_bytes\x18\x02 \x01(\x03R\n" +
+	"totalBytes\x12\x1b\n" +
+	"\tmax_bytes\x18\x03 \x01(\x03R\bmaxBytes\x12\x15\n" +
+	"\x06ttl_ms\x18\x04 \x01(\x03R\x05ttlMs\x12\x18\n" +
+	"\acleared\x18\x05 \x01(\x05R\acleared\x12(\n" +
+	"\x05error\x18\x06 \x01(\v2\x12.proto.ErrorDetailR\x05error\"x\n" +
+	"\n" +
+	"CacheEntry\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03R\tsizeBytes\x12\x15\n" +
+	"\x06age_ms\x18\x03 \x01(\x03R\x05ageMs\x12\"\n" +
+	"\rexpires_in_ms\x18\x04 \x01(\x03R\vexpiresInMs\".\n" +
+	"\rCancelRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\".\n" +
+	"\x0eCancelResponse\x12\x1c\n" +
+	"\tcancelled\x18\x01 \x01(\bR\tcancelled\"e\n" +
 	"\x13ValidateFeedRequest\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"V\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x03 \x01(\x03R\ttimeoutMs\"{\n" +
 	"\x14ValidateFeedResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12(\n" +
-	"\x05error\x18\x02 \x01(\v2\x12.proto.ErrorDetailR\x05error\"'\n" +
+	"\x05error\x18\x02 \x01(\v2\x12.proto.ErrorDetailR\x05error\x12#\n" +
+	"\rcanonical_url\x18\x03 \x01(\tR\fcanonicalUrl\"^\n" +
+	"\x14NormalizeUrlsRequest\x12\x12\n" +
+	"\x04urls\x18\x01 \x03(\tR\x04urls\x122\n" +
+	"\x15strip_tracking_params\x18\x02 \x01(\bR\x13stripTrackingParams\"k\n" +
+	"\x15NormalizeUrlsResponse\x12(\n" +
+	"\x04urls\x18\x01 \x03(\v2\x14.proto.NormalizedUrlR\x04urls\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\x12.proto.ErrorDetailR\x05error\"m\n" +
+	"\rNormalizedUrl\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\tR\x05input\x12\x1c\n" +
+	"\tcanonical\x18\x02 \x01(\tR\tcanonical\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\x12.proto.ErrorDetailR\x05error\"G\n" +
+	"\x14DiscoverFeedsRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\"n\n" +
+	"\x15DiscoverFeedsResponse\x12+\n" +
+	"\x05feeds\x18\x01 \x03(\v2\x15.proto.DiscoveredFeedR\x05feeds\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\x12.proto.ErrorDetailR\x05error\"\x85\x01\n" +
+	"\x0eDiscoveredFeed\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12.\n" +
+	"\x06source\x18\x04 \x01(\x0e2\x16.proto.DiscoverySourceR\x06source\"\x85\x01\n" +
+	"\x12ResolveIconRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12!\n" +
+	"\finclude_data\x18\x03 \x01(\bR\vincludeData\x12\x1b\n" +
+	"\tmax_bytes\x18\x04 \x01(\x03R\bmaxBytes\"\x95\x01\n" +
+	"\x13ResolveIconResponse\x12#\n" +
+	"\x04icon\x18\x01 \x01(\v2\x0f.proto.FeedIconR\x04icon\x12/\n" +
+	"\n" +
+	"candidates\x18\x02 \x03(\v2\x0f.proto.FeedIconR\n" +
+	"candidates\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\x12.proto.ErrorDetailR\x05error\"\xd6\x01\n" +
+	"\bFeedIcon\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12 \n" +
+	"\tmime_type\x18\x02 \x01(\tH\x00R\bmimeType\x88\x01\x01\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\x12)\n" +
+	"\x06source\x18\x05 \x01(\x0e2\x11.proto.IconSourceR\x06source\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\x12\x1b\n" +
+	"\tbyte_size\x18\a \x01(\x03R\bbyteSizeB\f\n" +
+	"\n" +
+	"_mime_type\"b\n" +
+	"\x11ImportOpmlRequest\x12\x12\n" +
+	"\x04opml\x18\x01 \x01(\fR\x04opml\x12\x1a\n" +
+	"\bvalidate\x18\x02 \x01(\bR\bvalidate\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\"\x93\x01\n" +
+	"\x12ImportOpmlResponse\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12=\n" +
+	"\rsubscriptions\x18\x02 \x03(\v2\x17.proto.OpmlSubscriptionR\rsubscriptions\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\x12.proto.ErrorDetailR\x05error\"\xf2\x01\n" +
+	"\x10OpmlSubscription\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x17\n" +
+	"\axml_url\x18\x02 \x01(\tR\x06xmlUrl\x12\x1e\n" +
+	"\bhtml_url\x18\x03 \x01(\tH\x00R\ahtmlUrl\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x04 \x03(\tR\n" +
+	"categories\x12\x19\n" +
+	"\x05valid\x18\x05 \x01(\bH\x01R\x05valid\x88\x01\x01\x12=\n" +
+	"\x10validation_error\x18\x06 \x01(\v2\x12.proto.ErrorDetailR\x0fvalidationErrorB\v\n" +
+	"\t_html_urlB\b\n" +
+	"\x06_valid\"L\n" +
+	"\x11ExportOpmlRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12!\n" +
+	"\x05feeds\x18\x02 \x03(\v2\v.proto.FeedR\x05feeds\"R\n" +
+	"\x12ExportOpmlResponse\x12\x12\n" +
+	"\x04opml\x18\x01 \x01(\fR\x04opml\x12(\n" +
+	"\x05error\x18\x02 \x01(\v2\x12.proto.ErrorDetailR\x05error\"\xc8\x04\n" +
 	"\x11ParseFeedsRequest\x12\x12\n" +
-	"\x04urls\x18\x01 \x03(\tR\x04urls\"\xc9\x01\n" +
+	"\x04urls\x18\x01 \x03(\tR\x04urls\x125\n" +
+	"\n" +
+	"validators\x18\x02 \x03(\v2\x15.proto.FeedValidatorsR\n" +
+	"validators\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x04 \x01(\x03R\ttimeoutMs\x12&\n" +
+	"\x0ffeed_timeout_ms\x18\x05 \x01(\x03R\rfeedTimeoutMs\x12'\n" +
+	"\x0fmax_concurrency\x18\x06 \x01(\x05R\x0emaxConcurrency\x12(\n" +
+	"\x05retry\x18\a \x01(\v2\x12.proto.RetryPolicyR\x05retry\x12;\n" +
+	"\x0econtent_format\x18\b \x01(\x0e2\x14.proto.ContentFormatR\rcontentFormat\x12$\n" +
+	"\x0efetch_og_image\x18\t \x01(\bR\ffetchOgImage\x12!\n" +
+	"\fbypass_cache\x18\n" +
+	" \x01(\bR\vbypassCache\x12#\n" +
+	"\rrefresh_cache\x18\v \x01(\bR\frefreshCache\x12)\n" +
+	"\x06limits\x18\f \x01(\v2\x11.proto.FeedLimitsR\x06limits\x122\n" +
+	"\x15strip_tracking_params\x18\r \x01(\bR\x13stripTrackingParams\x12%\n" +
+	"\x0epreserve_order\x18\x0e \x01(\bR\rpreserveOrder\"\xdd\x01\n" +
+	"\n" +
+	"FeedLimits\x12$\n" +
+	"\x0emax_body_bytes\x18\x01 \x01(\x03R\fmaxBodyBytes\x12\x1b\n" +
+	"\tmax_items\x18\x02 \x01(\x05R\bmaxItems\x12(\n" +
+	"\x10max_title_length\x18\x03 \x01(\x05R\x0emaxTitleLength\x124\n" +
+	"\x16max_description_length\x18\x04 \x01(\x05R\x14maxDescriptionLength\x12,\n" +
+	"\x12max_content_length\x18\x05 \x01(\x05R\x10maxContentLength\"\x9e\x01\n" +
+	"\vRetryPolicy\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x12\"\n" +
+	"\rbase_delay_ms\x18\x02 \x01(\x03R\vbaseDelayMs\x12 \n" +
+	"\fmax_delay_ms\x18\x03 \x01(\x03R\n" +
+	"maxDelayMs\x12\x1b\n" +
+	"\x06jitter\x18\x04 \x01(\x01H\x00R\x06jitter\x88\x01\x01B\t\n" +
+	"\a_jitter\"\x80\x01\n" +
+	"\x0eFeedValidators\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x17\n" +
+	"\x04etag\x18\x02 \x01(\tH\x00R\x04etag\x88\x01\x01\x12(\n" +
+	"\rlast_modified\x18\x03 \x01(\tH\x01R\flastModified\x88\x01\x01B\a\n" +
+	"\x05_etagB\x10\n" +
+	"\x0e_last_modified\"\xc9\x01\n" +
 	"\x12ParseFeedsResponse\x12/\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x17.proto.ParseFeedsStatusR\x06status\x12!\n" +
 	"\x05feeds\x18\x02 \x03(\v2\v.proto.FeedR\x05feeds\x12*\n" +
 	"\x06errors\x18\x03 \x03(\v2\x12.proto.ErrorDetailR\x06errors\x123\n" +
 	"\vfatal_error\x18\x04 \x01(\v2\x12.proto.ErrorDetailR\n" +
-	"fatalError\"\xb1\x01\n" +
+	"fatalError\"\xa1\x01\n" +
+	"\x10ParseStreamEvent\x12!\n" +
+	"\x04feed\x18\x01 \x01(\v2\v.proto.FeedH\x00R\x04feed\x12*\n" +
+	"\x05error\x18\x02 \x01(\v2\x12.proto.ErrorDetailH\x00R\x05error\x125\n" +
+	"\asummary\x18\x03 \x01(\v2\x19.proto.ParseStreamSummaryH\x00R\asummaryB\a\n" +
+	"\x05event\"\xba\x01\n" +
+	"\x12ParseStreamSummary\x12/\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x17.proto.ParseFeedsStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"feed_count\x18\x02 \x01(\x05R\tfeedCount\x12\x1f\n" +
+	"\verror_count\x18\x03 \x01(\x05R\n" +
+	"errorCount\x123\n" +
+	"\vfatal_error\x18\x04 \x01(\v2\x12.proto.ErrorDetailR\n" +
+	"fatalError\"\xb4\x05\n" +
 	"\x04Feed\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x19\n" +
 	"\x05image\x18\x05 \x01(\tH\x01R\x05image\x88\x01\x01\x12%\n" +
-	"\x05items\x18\x06 \x03(\v2\x0f.proto.FeedItemR\x05itemsB\x0e\n" +
+	"\x05items\x18\x06 \x03(\v2\x0f.proto.FeedItemR\x05items\x12\x17\n" +
+	"\x04etag\x18\a \x01(\tH\x02R\x04etag\x88\x01\x01\x12(\n" +
+	"\rlast_modified\x18\b \x01(\tH\x03R\flastModified\x88\x01\x01\x12!\n" +
+	"\fnot_modified\x18\t \x01(\bR\vnotModified\x12\x17\n" +
+	"\x04link\x18\n" +
+	" \x01(\tH\x04R\x04link\x88\x01\x01\x12/\n" +
+	"\apodcast\x18\v \x01(\v2\x15.proto.PodcastChannelR\apodcast\x129\n" +
+	"\vdiagnostics\x18\f \x01(\v2\x17.proto.FetchDiagnosticsR\vdiagnostics\x123\n" +
+	"\tjson_feed\x18\r \x01(\v2\x16.proto.JsonFeedChannelR\bjsonFeed\x12\x16\n" +
+	"\x06cached\x18\x0e \x01(\bR\x06cached\x12 \n" +
+	"\fcache_age_ms\x18\x0f \x01(\x03R\n" +
+	"cacheAgeMs\x12\x1c\n" +
+	"\ttruncated\x18\x10 \x01(\bR\ttruncated\x12#\n" +
+	"\rcanonical_url\x18\x11 \x01(\tR\fcanonicalUrl\x12\x1f\n" +
+	"\vmerged_urls\x18\x12 \x03(\tR\n" +
+	"mergedUrls\x12\x1f\n" +
+	"\vinput_index\x18\x13 \x01(\x05R\n" +
+	"inputIndexB\x0e\n" +
 	"\f_descriptionB\b\n" +
-	"\x06_image\"\xcf\x01\n" +
+	"\x06_imageB\a\n" +
+	"\x05_etagB\x10\n" +
+	"\x0e_last_modifiedB\a\n" +
+	"\x05_link\"\xe4\x02\n" +
+	"\x0fJsonFeedChannel\x12'\n" +
+	"\rhome_page_url\x18\x01 \x01(\tH\x00R\vhomePageUrl\x88\x01\x01\x12\x1e\n" +
+	"\bfeed_url\x18\x02 \x01(\tH\x01R\afeedUrl\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x03 \x01(\tH\x02R\x04icon\x88\x01\x01\x12\x1d\n" +
+	"\afavicon\x18\x04 \x01(\tH\x03R\afavicon\x88\x01\x01\x12\x1e\n" +
+	"\bnext_url\x18\x05 \x01(\tH\x04R\anextUrl\x88\x01\x01\x12\x1f\n" +
+	"\blanguage\x18\x06 \x01(\tH\x05R\blanguage\x88\x01\x01\x12\x18\n" +
+	"\aexpired\x18\a \x01(\bR\aexpired\x12'\n" +
+	"\aauthors\x18\b \x03(\v2\r.proto.AuthorR\aauthorsB\x10\n" +
+	"\x0e_home_page_urlB\v\n" +
+	"\t_feed_urlB\a\n" +
+	"\x05_iconB\n" +
+	"\n" +
+	"\b_faviconB\v\n" +
+	"\t_next_urlB\v\n" +
+	"\t_language\"c\n" +
+	"\x06Author\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
+	"\x03url\x18\x02 \x01(\tH\x00R\x03url\x88\x01\x01\x12\x1b\n" +
+	"\x06avatar\x18\x03 \x01(\tH\x01R\x06avatar\x88\x01\x01B\x06\n" +
+	"\x04_urlB\t\n" +
+	"\a_avatar\"\xa3\x03\n" +
+	"\x0ePodcastChannel\x12\x1b\n" +
+	"\x06author\x18\x01 \x01(\tH\x00R\x06author\x88\x01\x01\x12\x1d\n" +
+	"\asummary\x18\x02 \x01(\tH\x01R\asummary\x88\x01\x01\x12\x19\n" +
+	"\x05image\x18\x03 \x01(\tH\x02R\x05image\x88\x01\x01\x12\x1a\n" +
+	"\bexplicit\x18\x04 \x01(\bR\bexplicit\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x05 \x03(\tR\n" +
+	"categories\x12\x17\n" +
+	"\x04type\x18\x06 \x01(\tH\x03R\x04type\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"owner_name\x18\a \x01(\tH\x04R\townerName\x88\x01\x01\x12$\n" +
+	"\vowner_email\x18\b \x01(\tH\x05R\n" +
+	"ownerEmail\x88\x01\x01\x12\x1a\n" +
+	"\bcomplete\x18\t \x01(\bR\bcomplete\x12%\n" +
+	"\fnew_feed_url\x18\n" +
+	" \x01(\tH\x06R\n" +
+	"newFeedUrl\x88\x01\x01B\t\n" +
+	"\a_authorB\n" +
+	"\n" +
+	"\b_summaryB\b\n" +
+	"\x06_imageB\a\n" +
+	"\x05_typeB\r\n" +
+	"\v_owner_nameB\x0e\n" +
+	"\f_owner_emailB\x0f\n" +
+	"\r_new_feed_url\"\xb9\x04\n" +
 	"\bFeedItem\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x17\n" +
 	"\x04link\x18\x03 \x01(\tH\x01R\x04link\x88\x01\x01\x12\x19\n" +
 	"\x05image\x18\x04 \x01(\tH\x02R\x05image\x88\x01\x01\x12!\n" +
-	"\tpublished\x18\x05 \x01(\tH\x03R\tpublished\x88\x01\x01B\x0e\n" +
+	"\tpublished\x18\x05 \x01(\tH\x03R\tpublished\x88\x01\x01\x120\n" +
+	"\n" +
+	"enclosures\x18\x06 \x03(\v2\x10.proto.EnclosureR\n" +
+	"enclosures\x12/\n" +
+	"\apodcast\x18\a \x01(\v2\x15.proto.PodcastEpisodeR\apodcast\x12\x0e\n" +
+	"\x02id\x18\b \x01(\tR\x02id\x12\x17\n" +
+	"\x04guid\x18\t \x01(\tH\x04R\x04guid\x88\x01\x01\x12!\n" +
+	"\fcontent_hash\x18\n" +
+	" \x01(\tR\vcontentHash\x120\n" +
+	"\tjson_feed\x18\v \x01(\v2\x13.proto.JsonFeedItemR\bjsonFeed\x12\x1d\n" +
+	"\acontent\x18\f \x01(\tH\x05R\acontent\x88\x01\x01\x125\n" +
+	"\fimage_source\x18\r \x01(\x0e2\x12.proto.ImageSourceR\vimageSource\x12\x1c\n" +
+	"\ttruncated\x18\x0e \x01(\bR\ttruncatedB\x0e\n" +
 	"\f_descriptionB\a\n" +
 	"\x05_linkB\b\n" +
 	"\x06_imageB\f\n" +
 	"\n" +
-	"_published*\xa5\x01\n" +
+	"_publishedB\a\n" +
+	"\x05_guidB\n" +
+	"\n" +
+	"\b_content\"\xf9\x03\n" +
+	"\fJsonFeedItem\x12&\n" +
+	"\fcontent_html\x18\x01 \x01(\tH\x00R\vcontentHtml\x88\x01\x01\x12&\n" +
+	"\fcontent_text\x18\x02 \x01(\tH\x01R\vcontentText\x88\x01\x01\x12\x1d\n" +
+	"\asummary\x18\x03 \x01(\tH\x02R\asummary\x88\x01\x01\x12&\n" +
+	"\fbanner_image\x18\x04 \x01(\tH\x03R\vbannerImage\x88\x01\x01\x12&\n" +
+	"\fexternal_url\x18\x05 \x01(\tH\x04R\vexternalUrl\x88\x01\x01\x12(\n" +
+	"\rdate_modified\x18\x06 \x01(\tH\x05R\fdateModified\x88\x01\x01\x12'\n" +
+	"\aauthors\x18\a \x03(\v2\r.proto.AuthorR\aauthors\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x123\n" +
+	"\vattachments\x18\t \x03(\v2\x11.proto.AttachmentR\vattachments\x12\x1f\n" +
+	"\blanguage\x18\n" +
+	" \x01(\tH\x06R\blanguage\x88\x01\x01B\x0f\n" +
+	"\r_content_htmlB\x0f\n" +
+	"\r_content_textB\n" +
+	"\n" +
+	"\b_summaryB\x0f\n" +
+	"\r_banner_imageB\x0f\n" +
+	"\r_external_urlB\x10\n" +
+	"\x0e_date_modifiedB\v\n" +
+	"\t_language\"\xe0\x01\n" +
+	"\n" +
+	"Attachment\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x12'\n" +
+	"\rsize_in_bytes\x18\x04 \x01(\x03H\x01R\vsizeInBytes\x88\x01\x01\x12.\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x03H\x02R\x0fdurationSeconds\x88\x01\x01B\b\n" +
+	"\x06_titleB\x10\n" +
+	"\x0e_size_in_bytesB\x13\n" +
+	"\x11_duration_seconds\"u\n" +
+	"\tEnclosure\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12 \n" +
+	"\tmime_type\x18\x02 \x01(\tH\x00R\bmimeType\x88\x01\x01\x12\x1b\n" +
+	"\x06length\x18\x03 \x01(\x03H\x01R\x06length\x88\x01\x01B\f\n" +
+	"\n" +
+	"_mime_typeB\t\n" +
+	"\a_length\"\xd0\x02\n" +
+	"\x0ePodcastEpisode\x12\x1f\n" +
+	"\bduration\x18\x01 \x01(\tH\x00R\bduration\x88\x01\x01\x12.\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x03H\x01R\x0fdurationSeconds\x88\x01\x01\x12\x1d\n" +
+	"\aepisode\x18\x03 \x01(\x05H\x02R\aepisode\x88\x01\x01\x12\x1b\n" +
+	"\x06season\x18\x04 \x01(\x05H\x03R\x06season\x88\x01\x01\x12\x1a\n" +
+	"\bexplicit\x18\x05 \x01(\bR\bexplicit\x12\x19\n" +
+	"\x05image\x18\x06 \x01(\tH\x04R\x05image\x88\x01\x01\x12&\n" +
+	"\fepisode_type\x18\a \x01(\tH\x05R\vepisodeType\x88\x01\x01B\v\n" +
+	"\t_durationB\x13\n" +
+	"\x11_duration_secondsB\n" +
+	"\n" +
+	"\b_episodeB\t\n" +
+	"\a_seasonB\b\n" +
+	"\x06_imageB\x0f\n" +
+	"\r_episode_type*\xb7\x03\n" +
 	"\tErrorKind\x12\x16\n" +
 	"\x12ERROR_KIND_UNKNOWN\x10\x00\x12\x1c\n" +
 	"\x18ERROR_KIND_SERIALIZATION\x10\x01\x12\x16\n" +
 	"\x12ERROR_KIND_NETWORK\x10\x02\x12\x16\n" +
 	"\x12ERROR_KIND_PARSING\x10\x03\x12\x19\n" +
 	"\x15ERROR_KIND_VALIDATION\x10\x04\x12\x17\n" +
-	"\x13ERROR_KIND_INTERNAL\x10\x05*7\n" +
+	"\x13ERROR_KIND_INTERNAL\x10\x05\x12\x18\n" +
+	"\x14ERROR_KIND_CANCELLED\x10\x06\x12\x1a\n" +
+	"\x16ERROR_KIND_HTTP_STATUS\x10\a\x12\x12\n" +
+	"\x0eERROR_KIND_DNS\x10\b\x12\x12\n" +
+	"\x0eERROR_KIND_TLS\x10\t\x12\x16\n" +
+	"\x12ERROR_KIND_TIMEOUT\x10\n" +
+	"\x12!\n" +
+	"\x1dERROR_KIND_TOO_MANY_REDIRECTS\x10\v\x12\x1b\n" +
+	"\x17ERROR_KIND_RATE_LIMITED\x10\f\x12\x19\n" +
+	"\x15ERROR_KIND_NOT_A_FEED\x10\r\x12\x1d\n" +
+	"\x19ERROR_KIND_BODY_TOO_LARGE\x10\x0e\x12 \n" +
+	"\x1cERROR_KIND_BLOCKED_BY_POLICY\x10\x0f*\x87\x01\n" +
+	"\x0fHttpCacheStatus\x12\x1a\n" +
+	"\x16HTTP_CACHE_STATUS_NONE\x10\x00\x12\x1a\n" +
+	"\x16HTTP_CACHE_STATUS_MISS\x10\x01\x12\x19\n" +
+	"\x15HTTP_CACHE_STATUS_HIT\x10\x02\x12!\n" +
+	"\x1dHTTP_CACHE_STATUS_REVALIDATED\x10\x03*O\n" +
+	"\n" +
+	"TlsVersion\x12\x17\n" +
+	"\x13TLS_VERSION_DEFAULT\x10\x00\x12\x13\n" +
+	"\x0fTLS_VERSION_1_2\x10\x01\x12\x13\n" +
+	"\x0fTLS_VERSION_1_3\x10\x02*?\n" +
+	"\vCacheAction\x12\x18\n" +
+	"\x14CACHE_ACTION_INSPECT\x10\x00\x12\x16\n" +
+	"\x12CACHE_ACTION_CLEAR\x10\x01*\x91\x01\n" +
+	"\x0fDiscoverySource\x12\x1c\n" +
+	"\x18DISCOVERY_SOURCE_UNKNOWN\x10\x00\x12\x1b\n" +
+	"\x17DISCOVERY_SOURCE_DIRECT\x10\x01\x12\x1d\n" +
+	"\x19DISCOVERY_SOURCE_LINK_TAG\x10\x02\x12$\n" +
+	" DISCOVERY_SOURCE_WELL_KNOWN_PATH\x10\x03*\x91\x01\n" +
+	"\n" +
+	"IconSource\x12\x17\n" +
+	"\x13ICON_SOURCE_UNKNOWN\x10\x00\x12\x14\n" +
+	"\x10ICON_SOURCE_FEED\x10\x01\x12\x19\n" +
+	"\x15ICON_SOURCE_LINK_ICON\x10\x02\x12 \n" +
+	"\x1cICON_SOURCE_APPLE_TOUCH_ICON\x10\x03\x12\x17\n" +
+	"\x13ICON_SOURCE_FAVICON\x10\x04*c\n" +
+	"\rContentFormat\x12\x1a\n" +
+	"\x16CONTENT_FORMAT_DEFAULT\x10\x00\x12\x1d\n" +
+	"\x19CONTENT_FORMAT_PLAIN_TEXT\x10\x01\x12\x17\n" +
+	"\x13CONTENT_FORMAT_HTML\x10\x02*7\n" +
 	"\x10ParseFeedsStatus\x12\v\n" +
 	"\aSUCCESS\x10\x00\x12\t\n" +
 	"\x05ERROR\x10\x01\x12\v\n" +
-	"\aPARTIAL\x10\x02B\"Z github.com/sunderee/rss-it/protob\x06proto3"
+	"\aPARTIAL\x10\x02*\xc0\x01\n" +
+	"\vImageSource\x12\x15\n" +
+	"\x11IMAGE_SOURCE_NONE\x10\x00\x12\x15\n" +
+	"\x11IMAGE_SOURCE_FEED\x10\x01\x12\x18\n" +
+	"\x14IMAGE_SOURCE_PODCAST\x10\x02\x12\x16\n" +
+	"\x12IMAGE_SOURCE_MEDIA\x10\x03\x12\x1a\n" +
+	"\x16IMAGE_SOURCE_ENCLOSURE\x10\x04\x12\x18\n" +
+	"\x14IMAGE_SOURCE_CONTENT\x10\x05\x12\x1b\n" +
+	"\x17IMAGE_SOURCE_OPEN_GRAPH\x10\x06B\"Z github.com/sunderee/rss-it/protob\x06proto3"
 
 var (
 	file_feed_proto_rawDescOnce sync.Once
@@ -614,32 +4312,116 @@ func file_feed_proto_rawDescGZIP() []byte {
 	return file_feed_proto_rawDescData
 }
 
-var file_feed_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_feed_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_feed_proto_goTypes = []any{
-	(ErrorKind)(0),               // 0: proto.ErrorKind
-	(ParseFeedsStatus)(0),        // 1: proto.ParseFeedsStatus
-	(*ErrorDetail)(nil),          // 2: proto.ErrorDetail
-	(*ValidateFeedRequest)(nil),  // 3: proto.ValidateFeedRequest
-	(*ValidateFeedResponse)(nil), // 4: proto.ValidateFeedResponse
-	(*ParseFeedsRequest)(nil),    // 5: proto.ParseFeedsRequest
-	(*ParseFeedsResponse)(nil),   // 6: proto.ParseFeedsResponse
-	(*Feed)(nil),                 // 7: proto.Feed
-	(*FeedItem)(nil),             // 8: proto.FeedItem
+	(ErrorKind)(0),                // 0: proto.ErrorKind
+	(HttpCacheStatus)(0),          // 1: proto.HttpCacheStatus
+	(TlsVersion)(0),               // 2: proto.TlsVersion
+	(CacheAction)(0),              // 3: proto.CacheAction
+	(DiscoverySource)(0),          // 4: proto.DiscoverySource
+	(IconSource)(0),               // 5: proto.IconSource
+	(ContentFormat)(0),            // 6: proto.ContentFormat
+	(ParseFeedsStatus)(0),         // 7: proto.ParseFeedsStatus
+	(ImageSource)(0),              // 8: proto.ImageSource
+	(*ErrorDetail)(nil),           // 9: proto.ErrorDetail
+	(*FetchDiagnostics)(nil),      // 10: proto.FetchDiagnostics
+	(*ClientConfig)(nil),          // 11: proto.ClientConfig
+	(*NetworkPolicy)(nil),         // 12: proto.NetworkPolicy
+	(*ConfigureResponse)(nil),     // 13: proto.ConfigureResponse
+	(*CacheRequest)(nil),          // 14: proto.CacheRequest
+	(*CacheResponse)(nil),         // 15: proto.CacheResponse
+	(*CacheEntry)(nil),            // 16: proto.CacheEntry
+	(*CancelRequest)(nil),         // 17: proto.CancelRequest
+	(*CancelResponse)(nil),        // 18: proto.CancelResponse
+	(*ValidateFeedRequest)(nil),   // 19: proto.ValidateFeedRequest
+	(*ValidateFeedResponse)(nil),  // 20: proto.ValidateFeedResponse
+	(*NormalizeUrlsRequest)(nil),  // 21: proto.NormalizeUrlsRequest
+	(*NormalizeUrlsResponse)(nil), // 22: proto.NormalizeUrlsResponse
+	(*NormalizedUrl)(nil),         // 23: proto.NormalizedUrl
+	(*DiscoverFeedsRequest)(nil),  // 24: proto.DiscoverFeedsRequest
+	(*DiscoverFeedsResponse)(nil), // 25: proto.DiscoverFeedsResponse
+	(*DiscoveredFeed)(nil),        // 26: proto.DiscoveredFeed
+	(*ResolveIconRequest)(nil),    // 27: proto.ResolveIconRequest
+	(*ResolveIconResponse)(nil),   // 28: proto.ResolveIconResponse
+	(*FeedIcon)(nil),              // 29: proto.FeedIcon
+	(*ImportOpmlRequest)(nil),     // 30: proto.ImportOpmlRequest
+	(*ImportOpmlResponse)(nil),    // 31: proto.ImportOpmlResponse
+	(*OpmlSubscription)(nil),      // 32: proto.OpmlSubscription
+	(*ExportOpmlRequest)(nil),     // 33: proto.ExportOpmlRequest
+	(*ExportOpmlResponse)(nil),    // 34: proto.ExportOpmlResponse
+	(*ParseFeedsRequest)(nil),     // 35: proto.ParseFeedsRequest
+	(*FeedLimits)(nil),            // 36: proto.FeedLimits
+	(*RetryPolicy)(nil),           // 37: proto.RetryPolicy
+	(*FeedValidators)(nil),        // 38: proto.FeedValidators
+	(*ParseFeedsResponse)(nil),    // 39: proto.ParseFeedsResponse
+	(*ParseStreamEvent)(nil),      // 40: proto.ParseStreamEvent
+	(*ParseStreamSummary)(nil),    // 41: proto.ParseStreamSummary
+	(*Feed)(nil),                  // 42: proto.Feed
+	(*JsonFeedChannel)(nil),       // 43: proto.JsonFeedChannel
+	(*Author)(nil),                // 44: proto.Author
+	(*PodcastChannel)(nil),        // 45: proto.PodcastChannel
+	(*FeedItem)(nil),              // 46: proto.FeedItem
+	(*JsonFeedItem)(nil),          // 47: proto.JsonFeedItem
+	(*Attachment)(nil),            // 48: proto.Attachment
+	(*Enclosure)(nil),             // 49: proto.Enclosure
+	(*PodcastEpisode)(nil),        // 50: proto.PodcastEpisode
 }
 var file_feed_proto_depIdxs = []int32{
-	0, // 0: proto.ErrorDetail.kind:type_name -> proto.ErrorKind
-	2, // 1: proto.ValidateFeedResponse.error:type_name -> proto.ErrorDetail
-	1, // 2: proto.ParseFeedsResponse.status:type_name -> proto.ParseFeedsStatus
-	7, // 3: proto.ParseFeedsResponse.feeds:type_name -> proto.Feed
-	2, // 4: proto.ParseFeedsResponse.errors:type_name -> proto.ErrorDetail
-	2, // 5: proto.ParseFeedsResponse.fatal_error:type_name -> proto.ErrorDetail
-	8, // 6: proto.Feed.items:type_name -> proto.FeedItem
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	0,  // 0: proto.ErrorDetail.kind:type_name -> proto.ErrorKind
+	10, // 1: proto.ErrorDetail.diagnostics:type_name -> proto.FetchDiagnostics
+	1,  // 2: proto.FetchDiagnostics.http_cache:type_name -> proto.HttpCacheStatus
+	2,  // 3: proto.ClientConfig.min_tls_version:type_name -> proto.TlsVersion
+	12, // 4: proto.ClientConfig.network_policy:type_name -> proto.NetworkPolicy
+	9,  // 5: proto.ConfigureResponse.error:type_name -> proto.ErrorDetail
+	3,  // 6: proto.CacheRequest.action:type_name -> proto.CacheAction
+	16, // 7: proto.CacheResponse.entries:type_name -> proto.CacheEntry
+	9,  // 8: proto.CacheResponse.error:type_name -> proto.ErrorDetail
+	9,  // 9: proto.ValidateFeedResponse.error:type_name -> proto.ErrorDetail
+	23, // 10: proto.NormalizeUrlsResponse.urls:type_name -> proto.NormalizedUrl
+	9,  // 11: proto.NormalizeUrlsResponse.error:type_name -> proto.ErrorDetail
+	9,  // 12: proto.NormalizedUrl.error:type_name -> proto.ErrorDetail
+	26, // 13: proto.DiscoverFeedsResponse.feeds:type_name -> proto.DiscoveredFeed
+	9,  // 14: proto.DiscoverFeedsResponse.error:type_name -> proto.ErrorDetail
+	4,  // 15: proto.DiscoveredFeed.source:type_name -> proto.DiscoverySource
+	29, // 16: proto.ResolveIconResponse.icon:type_name -> proto.FeedIcon
+	29, // 17: proto.ResolveIconResponse.candidates:type_name -> proto.FeedIcon
+	9,  // 18: proto.ResolveIconResponse.error:type_name -> proto.ErrorDetail
+	5,  // 19: proto.FeedIcon.source:type_name -> proto.IconSource
+	32, // 20: proto.ImportOpmlResponse.subscriptions:type_name -> proto.OpmlSubscription
+	9,  // 21: proto.ImportOpmlResponse.error:type_name -> proto.ErrorDetail
+	9,  // 22: proto.OpmlSubscription.validation_error:type_name -> proto.ErrorDetail
+	42, // 23: proto.ExportOpmlRequest.feeds:type_name -> proto.Feed
+	9,  // 24: proto.ExportOpmlResponse.error:type_name -> proto.ErrorDetail
+	38, // 25: proto.ParseFeedsRequest.validators:type_name -> proto.FeedValidators
+	37, // 26: proto.ParseFeedsRequest.retry:type_name -> proto.RetryPolicy
+	6,  // 27: proto.ParseFeedsRequest.content_format:type_name -> proto.ContentFormat
+	36, // 28: proto.ParseFeedsRequest.limits:type_name -> proto.FeedLimits
+	7,  // 29: proto.ParseFeedsResponse.status:type_name -> proto.ParseFeedsStatus
+	42, // 30: proto.ParseFeedsResponse.feeds:type_name -> proto.Feed
+	9,  // 31: proto.ParseFeedsResponse.errors:type_name -> proto.ErrorDetail
+	9,  // 32: proto.ParseFeedsResponse.fatal_error:type_name -> proto.ErrorDetail
+	42, // 33: proto.ParseStreamEvent.feed:type_name -> proto.Feed
+	9,  // 34: proto.ParseStreamEvent.error:type_name -> proto.ErrorDetail
+	41, // 35: proto.ParseStreamEvent.summary:type_name -> proto.ParseStreamSummary
+	7,  // 36: proto.ParseStreamSummary.status:type_name -> proto.ParseFeedsStatus
+	9,  // 37: proto.ParseStreamSummary.fatal_error:type_name -> proto.ErrorDetail
+	46, // 38: proto.Feed.items:type_name -> proto.FeedItem
+	45, // 39: proto.Feed.podcast:type_name -> proto.PodcastChannel
+	10, // 40: proto.Feed.diagnostics:type_name -> proto.FetchDiagnostics
+	43, // 41: proto.Feed.json_feed:type_name -> proto.JsonFeedChannel
+	44, // 42: proto.JsonFeedChannel.authors:type_name -> proto.Author
+	49, // 43: proto.FeedItem.enclosures:type_name -> proto.Enclosure
+	50, // 44: proto.FeedItem.podcast:type_name -> proto.PodcastEpisode
+	47, // 45: proto.FeedItem.json_feed:type_name -> proto.JsonFeedItem
+	8,  // 46: proto.FeedItem.image_source:type_name -> proto.ImageSource
+	44, // 47: proto.JsonFeedItem.authors:type_name -> proto.Author
+	48, // 48: proto.JsonFeedItem.attachments:type_name -> proto.Attachment
+	49, // [49:49] is the sub-list for method output_type
+	49, // [49:49] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_feed_proto_init() }
@@ -647,15 +4429,33 @@ func file_feed_proto_init() {
 	if File_feed_proto != nil {
 		return
 	}
-	file_feed_proto_msgTypes[5].OneofWrappers = []any{}
-	file_feed_proto_msgTypes[6].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[0].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[2].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[20].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[23].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[28].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[29].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[31].OneofWrappers = []any{
+		(*ParseStreamEvent_Feed)(nil),
+		(*ParseStreamEvent_Error)(nil),
+		(*ParseStreamEvent_Summary)(nil),
+	}
+	file_feed_proto_msgTypes[33].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[34].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[35].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[36].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[37].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[38].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[39].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[40].OneofWrappers = []any{}
+	file_feed_proto_msgTypes[41].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_feed_proto_rawDesc), len(file_feed_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      9,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
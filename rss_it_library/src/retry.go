@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+const (
+	defaultRetryAttempts  = 3
+	maxRetryAttempts      = 10
+	defaultRetryBaseDelay = 500 * time.Millisecond
+	defaultRetryMaxDelay  = 30 * time.Second
+	defaultRetryJitter    = 0.2
+)
+
+// retryPolicy controls how often and how patiently transient fetch failures are retried.
+type retryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	jitter      float64
+}
+
+// newRetryPolicy converts the request's RetryPolicy into a retryPolicy. A nil policy disables retries, while
+// zero-valued fields fall back to their defaults.
+func newRetryPolicy(config *pb.RetryPolicy) retryPolicy {
+	if config == nil {
+		return retryPolicy{maxAttempts: 1}
+	}
+
+	attempts := int(config.GetMaxAttempts())
+	if attempts <= 0 {
+		attempts = defaultRetryAttempts
+	}
+
+	jitter := defaultRetryJitter
+	if config.Jitter != nil {
+		jitter = min(max(config.GetJitter(), 0), 1)
+	}
+
+	return retryPolicy{
+		maxAttempts: min(attempts, maxRetryAttempts),
+		baseDelay:   durationOrDefault(config.GetBaseDelayMs(), defaultRetryBaseDelay),
+		maxDelay:    durationOrDefault(config.GetMaxDelayMs(), defaultRetryMaxDelay),
+		jitter:      jitter,
+	}
+}
+
+// backoff returns the wait before the given retry (1-based): the base delay doubled per retry, capped at the
+// maximum delay and reduced by up to the jitter fraction so that concurrent clients spread out.
+func (p retryPolicy) backoff(retry int) time.Duration {
+	delay := p.baseDelay
+	for i := 1; i < retry && delay < p.maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, p.maxDelay)
+
+	if p.jitter > 0 {
+		delay -= time.Duration(float64(delay) * p.jitter * rand.Float64())
+	}
+	return delay
+}
+
+// fetchFeedWithRetry calls fetchFeed, retrying transient failures according to policy. Retry-After hints are
+// honoured, and no retry is attempted if its wait would outlast ctx's deadline. The number of retries performed
+// is recorded in the returned result's diagnostics.
+func fetchFeedWithRetry(ctx context.Context, parser *gofeed.Parser, feedURL string, validators *pb.FeedValidators, policy retryPolicy, maxBodyBytes int64) (*fetchResult, error) {
+	for retry := 0; ; retry++ {
+		result, err := fetchFeed(ctx, parser, feedURL, validators, maxBodyBytes)
+		result.diagnostics.RetryCount = int32(retry)
+		if err == nil || retry+1 >= policy.maxAttempts {
+			return result, err
+		}
+
+		class := classifyError(err)
+		if !class.retryable {
+			return result, err
+		}
+
+		wait := policy.backoff(retry + 1)
+		if class.retryAfter > 0 {
+			// A server asking for more patience than the policy allows is treated as a final answer.
+			if class.retryAfter > policy.maxDelay {
+				return result, err
+			}
+			wait = max(wait, class.retryAfter)
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return result, err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, err
+		case <-timer.C:
+		}
+	}
+}
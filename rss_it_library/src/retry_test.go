@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+	goproto "google.golang.org/protobuf/proto"
+)
+
+// newFlakyFeedServer answers the first failures requests with status, then serves testRSSFeed.
+func newFlakyFeedServer(t *testing.T, failures int32, status int, retryAfter string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = w.Write([]byte(testRSSFeed))
+	}))
+	t.Cleanup(server.Close)
+	return server, &hits
+}
+
+func testRetryPolicy(maxAttempts int) retryPolicy {
+	return retryPolicy{maxAttempts: maxAttempts, baseDelay: time.Millisecond, maxDelay: 50 * time.Millisecond}
+}
+
+func TestFetchFeedWithRetry_RecoversFromTransientFailures(t *testing.T) {
+	server, hits := newFlakyFeedServer(t, 2, http.StatusServiceUnavailable, "")
+
+	result, err := fetchFeedWithRetry(context.Background(), gofeed.NewParser(), server.URL, nil, testRetryPolicy(3), defaultMaxBodyBytes)
+	if err != nil {
+		t.Fatalf("expected success after retries, got %v", err)
+	}
+	if hits.Load() != 3 {
+		t.Errorf("expected 3 requests, got %d", hits.Load())
+	}
+	if result.diagnostics.GetRetryCount() != 2 {
+		t.Errorf("expected retry count 2, got %d", result.diagnostics.GetRetryCount())
+	}
+	if result.feed == nil || result.feed.Title != "Test Feed" {
+		t.Errorf("expected parsed feed, got %+v", result.feed)
+	}
+}
+
+func TestFetchFeedWithRetry_GivesUpAfterMaxAttempts(t *testing.T) {
+	server, hits := newFlakyFeedServer(t, 5, http.StatusBadGateway, "")
+
+	result, err := fetchFeedWithRetry(context.Background(), gofeed.NewParser(), server.URL, nil, testRetryPolicy(2), defaultMaxBodyBytes)
+	if err == nil {
+		t.Fatal("expected error once attempts are exhausted")
+	}
+	if hits.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", hits.Load())
+	}
+	if result.diagnostics.GetRetryCount() != 1 {
+		t.Errorf("expected retry count 1, got %d", result.diagnostics.GetRetryCount())
+	}
+}
+
+func TestFetchFeedWithRetry_SkipsPermanentFailures(t *testing.T) {
+	server, hits := newFlakyFeedServer(t, 5, http.StatusNotFound, "")
+
+	if _, err := fetchFeedWithRetry(context.Background(), gofeed.NewParser(), server.URL, nil, testRetryPolicy(3), defaultMaxBodyBytes); err == nil {
+		t.Fatal("expected 404 to be reported")
+	}
+	if hits.Load() != 1 {
+		t.Errorf("expected a single request for a permanent failure, got %d", hits.Load())
+	}
+}
+
+func TestFetchFeedWithRetry_HonoursRetryAfter(t *testing.T) {
+	server, hits := newFlakyFeedServer(t, 1, http.StatusTooManyRequests, "1")
+	policy := retryPolicy{maxAttempts: 2, baseDelay: time.Millisecond, maxDelay: 5 * time.Second}
+
+	start := time.Now()
+	if _, err := fetchFeedWithRetry(context.Background(), gofeed.NewParser(), server.URL, nil, policy, defaultMaxBodyBytes); err != nil {
+		t.Fatalf("expected success after waiting, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected Retry-After to delay the retry by 1s, waited %v", elapsed)
+	}
+	if hits.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", hits.Load())
+	}
+}
+
+func TestFetchFeedWithRetry_RespectsDeadline(t *testing.T) {
+	server, hits := newFlakyFeedServer(t, 5, http.StatusServiceUnavailable, "")
+	policy := retryPolicy{maxAttempts: 5, baseDelay: time.Second, maxDelay: time.Second}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := fetchFeedWithRetry(ctx, gofeed.NewParser(), server.URL, nil, policy, defaultMaxBodyBytes); err == nil {
+		t.Fatal("expected error when the deadline leaves no room to retry")
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("expected to give up without sleeping past the deadline, took %v", elapsed)
+	}
+	if hits.Load() != 1 {
+		t.Errorf("expected a single request, got %d", hits.Load())
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := retryPolicy{maxAttempts: 5, baseDelay: 100 * time.Millisecond, maxDelay: 300 * time.Millisecond}
+
+	expected := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond, 300 * time.Millisecond}
+	for i, want := range expected {
+		if got := policy.backoff(i + 1); got != want {
+			t.Errorf("retry %d: expected %v, got %v", i+1, want, got)
+		}
+	}
+
+	policy.jitter = 0.5
+	for range 20 {
+		if got := policy.backoff(1); got < 50*time.Millisecond || got > 100*time.Millisecond {
+			t.Fatalf("expected jittered delay within [50ms, 100ms], got %v", got)
+		}
+	}
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+	if policy := newRetryPolicy(nil); policy.maxAttempts != 1 {
+		t.Errorf("expected nil policy to disable retries, got %d attempts", policy.maxAttempts)
+	}
+
+	defaults := newRetryPolicy(&pb.RetryPolicy{})
+	if defaults.maxAttempts != defaultRetryAttempts || defaults.baseDelay != defaultRetryBaseDelay ||
+		defaults.maxDelay != defaultRetryMaxDelay || defaults.jitter != defaultRetryJitter {
+		t.Errorf("expected defaults, got %+v", defaults)
+	}
+
+	custom := newRetryPolicy(&pb.RetryPolicy{MaxAttempts: 50, BaseDelayMs: 10, MaxDelayMs: 100, Jitter: goproto.Float64(2)})
+	if custom.maxAttempts != maxRetryAttempts {
+		t.Errorf("expected attempts to be capped at %d, got %d", maxRetryAttempts, custom.maxAttempts)
+	}
+	if custom.baseDelay != 10*time.Millisecond || custom.maxDelay != 100*time.Millisecond {
+		t.Errorf("expected custom delays, got %+v", custom)
+	}
+	if custom.jitter != 1 {
+		t.Errorf("expected jitter to be clamped to 1, got %v", custom.jitter)
+	}
+}
+
+func TestRSSParser_ParseFeeds_Retry(t *testing.T) {
+	server, _ := newFlakyFeedServer(t, 1, http.StatusServiceUnavailable, "")
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{
+		Urls:  []string{server.URL},
+		Retry: &pb.RetryPolicy{MaxAttempts: 2, BaseDelayMs: 1},
+	})
+
+	if response.Status != pb.ParseFeedsStatus_SUCCESS {
+		t.Fatalf("expected SUCCESS after retry, got %v (errors: %v)", response.Status, response.Errors)
+	}
+	if got := response.Feeds[0].GetDiagnostics().GetRetryCount(); got != 1 {
+		t.Errorf("expected retry count 1, got %d", got)
+	}
+}
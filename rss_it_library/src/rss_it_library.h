@@ -5,6 +5,17 @@
 
 #define FFI_PLUGIN_EXPORT
 
+typedef void (*parse_event_callback)(char* event);
+
+FFI_PLUGIN_EXPORT char* configure(const char* data, int length);
 FFI_PLUGIN_EXPORT char* validate(const char* data, int length);
 FFI_PLUGIN_EXPORT char* parse(const char* data, int length);
+FFI_PLUGIN_EXPORT void parse_stream(const char* data, int length, parse_event_callback callback);
+FFI_PLUGIN_EXPORT char* discover(const char* data, int length);
+FFI_PLUGIN_EXPORT char* resolve_icon(const char* data, int length);
+FFI_PLUGIN_EXPORT char* import_opml(const char* data, int length);
+FFI_PLUGIN_EXPORT char* export_opml(const char* data, int length);
+FFI_PLUGIN_EXPORT char* normalize_urls(const char* data, int length);
+FFI_PLUGIN_EXPORT char* cache(const char* data, int length);
+FFI_PLUGIN_EXPORT char* cancel(const char* data, int length);
 FFI_PLUGIN_EXPORT void free_result(char* ptr);
\ No newline at end of file
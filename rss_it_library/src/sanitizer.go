@@ -0,0 +1,191 @@
+package main
+
+import (
+	neturl "net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// allowedElements lists the markup kept by sanitizeHTML together with the attributes each element may carry.
+// Elements not listed here are unwrapped: their tags are dropped but their text is kept.
+var allowedElements = map[string][]string{
+	"a":          {"href", "title"},
+	"abbr":       {"title"},
+	"b":          nil,
+	"blockquote": {"cite"},
+	"br":         nil,
+	"caption":    nil,
+	"cite":       nil,
+	"code":       nil,
+	"dd":         nil,
+	"del":        nil,
+	"div":        nil,
+	"dl":         nil,
+	"dt":         nil,
+	"em":         nil,
+	"figcaption": nil,
+	"figure":     nil,
+	"h1":         nil,
+	"h2":         nil,
+	"h3":         nil,
+	"h4":         nil,
+	"h5":         nil,
+	"h6":         nil,
+	"hr":         nil,
+	"i":          nil,
+	"img":        {"src", "alt", "title", "width", "height"},
+	"ins":        nil,
+	"kbd":        nil,
+	"li":         nil,
+	"mark":       nil,
+	"ol":         {"start"},
+	"p":          nil,
+	"pre":        nil,
+	"q":          {"cite"},
+	"s":          nil,
+	"small":      nil,
+	"span":       nil,
+	"strong":     nil,
+	"sub":        nil,
+	"sup":        nil,
+	"table":      nil,
+	"tbody":      nil,
+	"td":         {"colspan", "rowspan"},
+	"tfoot":      nil,
+	"th":         {"colspan", "rowspan"},
+	"thead":      nil,
+	"tr":         nil,
+	"u":          nil,
+	"ul":         nil,
+}
+
+// droppedElements are removed together with everything inside them.
+var droppedElements = map[string]bool{
+	"applet": true, "audio": true, "base": true, "button": true, "embed": true, "form": true, "frame": true,
+	"frameset": true, "head": true, "iframe": true, "input": true, "link": true, "math": true, "meta": true,
+	"noscript": true, "object": true, "script": true, "select": true, "style": true, "svg": true, "template": true,
+	"textarea": true, "title": true, "video": true,
+}
+
+// voidElements never have children or a closing tag.
+var voidElements = map[string]bool{"br": true, "hr": true, "img": true}
+
+// urlAttributes hold URLs that must be resolved and checked against allowedURLSchemes.
+var urlAttributes = map[string]bool{"href": true, "src": true, "cite": true}
+
+// allowedURLSchemes lists the schemes permitted in URL attributes once resolved.
+var allowedURLSchemes = map[string]bool{"http": true, "https": true, "mailto": true}
+
+// sanitizeHTML rewrites an HTML fragment keeping only allowlisted elements and attributes. URLs are resolved
+// against base when it is non-nil, and any URL with a scheme outside allowedURLSchemes is dropped.
+func sanitizeHTML(fragment string, base *neturl.URL) string {
+	fragment = strings.TrimSpace(fragment)
+	if fragment == "" {
+		return ""
+	}
+
+	nodes, err := html.ParseFragment(strings.NewReader(fragment), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return html.EscapeString(cleanString(fragment))
+	}
+
+	var builder strings.Builder
+	for _, node := range nodes {
+		writeSanitized(&builder, node, base)
+	}
+	return strings.TrimSpace(builder.String())
+}
+
+// writeSanitized renders node and its children into builder, applying the allowlist.
+func writeSanitized(builder *strings.Builder, node *html.Node, base *neturl.URL) {
+	switch node.Type {
+	case html.TextNode:
+		builder.WriteString(html.EscapeString(node.Data))
+		return
+	case html.ElementNode:
+	default:
+		// Comments, doctypes and processing instructions are never kept.
+		return
+	}
+
+	if droppedElements[node.Data] || node.Namespace != "" {
+		return
+	}
+
+	attributes, allowed := allowedElements[node.Data]
+	if allowed {
+		builder.WriteByte('<')
+		builder.WriteString(node.Data)
+		for _, attr := range node.Attr {
+			value, ok := sanitizeAttribute(attr, attributes, base)
+			if !ok {
+				continue
+			}
+			builder.WriteByte(' ')
+			builder.WriteString(attr.Key)
+			builder.WriteString(`="`)
+			builder.WriteString(html.EscapeString(value))
+			builder.WriteByte('"')
+		}
+		builder.WriteByte('>')
+		if voidElements[node.Data] {
+			return
+		}
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		writeSanitized(builder, child, base)
+	}
+
+	if allowed {
+		builder.WriteString("</")
+		builder.WriteString(node.Data)
+		builder.WriteByte('>')
+	}
+}
+
+// sanitizeAttribute reports whether attr is permitted and returns its value, with URLs resolved against base.
+func sanitizeAttribute(attr html.Attribute, permitted []string, base *neturl.URL) (string, bool) {
+	if attr.Namespace != "" {
+		return "", false
+	}
+
+	for _, name := range permitted {
+		if attr.Key != name {
+			continue
+		}
+		if urlAttributes[name] {
+			return sanitizeURL(attr.Val, base)
+		}
+		return attr.Val, true
+	}
+	return "", false
+}
+
+// sanitizeURL resolves raw against base and rejects URLs with a disallowed scheme. Relative URLs are kept as-is
+// when there is no base to resolve them against.
+func sanitizeURL(raw string, base *neturl.URL) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", false
+	}
+
+	parsed, err := neturl.Parse(raw)
+	if err != nil {
+		return "", false
+	}
+	if base != nil {
+		parsed = base.ResolveReference(parsed)
+	}
+
+	if parsed.Scheme == "" {
+		// Still relative, so it cannot carry a javascript: or data: payload.
+		return parsed.String(), true
+	}
+	if !allowedURLSchemes[strings.ToLower(parsed.Scheme)] {
+		return "", false
+	}
+	return parsed.String(), true
+}
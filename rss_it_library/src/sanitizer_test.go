@@ -0,0 +1,82 @@
+package main
+
+import (
+	neturl "net/url"
+	"testing"
+)
+
+func TestSanitizeHTML(t *testing.T) {
+	base, _ := neturl.Parse("https://example.com/posts/1")
+
+	tests := []struct {
+		name     string
+		input    string
+		base     *neturl.URL
+		expected string
+	}{
+		{
+			name:     "keeps structure",
+			input:    `<h2>Title</h2><p>Hello <strong>there</strong><br/></p><ul><li>one</li><li>two</li></ul>`,
+			expected: `<h2>Title</h2><p>Hello <strong>there</strong><br></p><ul><li>one</li><li>two</li></ul>`,
+		},
+		{
+			name:     "keeps links and images",
+			input:    `<a href="https://example.com/" title="Home">home</a><img src="https://example.com/a.png" alt="A" width="10">`,
+			expected: `<a href="https://example.com/" title="Home">home</a><img src="https://example.com/a.png" alt="A" width="10">`,
+		},
+		{
+			name:     "removes scripts and frames with their content",
+			input:    `<p>Text</p><script>steal()</script><iframe src="https://evil.example">fallback</iframe><style>p{}</style>`,
+			expected: `<p>Text</p>`,
+		},
+		{
+			name:     "unwraps unknown elements",
+			input:    `<font color="red"><center>Old school</center></font>`,
+			expected: `Old school`,
+		},
+		{
+			name:     "drops event handlers and styling",
+			input:    `<p onclick="steal()" class="lead" style="color:red">Text</p>`,
+			expected: `<p>Text</p>`,
+		},
+		{
+			name:     "drops script URLs",
+			input:    `<a href=" JaVa&#09;Script:steal()">one</a><a href="javascript:steal()">two</a><img src="data:text/html;base64,AAAA">`,
+			expected: `<a>one</a><a>two</a><img>`,
+		},
+		{
+			name:     "resolves relative URLs",
+			input:    `<a href="../about">about</a><img src="/img/a.png"><blockquote cite="quote.html">q</blockquote>`,
+			base:     base,
+			expected: `<a href="https://example.com/about">about</a><img src="https://example.com/img/a.png"><blockquote cite="https://example.com/posts/quote.html">q</blockquote>`,
+		},
+		{
+			name:     "keeps relative URLs without a base",
+			input:    `<a href="/about">about</a>`,
+			expected: `<a href="/about">about</a>`,
+		},
+		{
+			name:     "escapes text",
+			input:    `Fish &amp; chips &lt;script&gt;`,
+			expected: `Fish &amp; chips &lt;script&gt;`,
+		},
+		{
+			name:     "drops svg",
+			input:    `<svg><script>steal()</script><text>hi</text></svg>ok`,
+			expected: `ok`,
+		},
+		{
+			name:     "drops comments",
+			input:    `<p>a<!-- secret -->b</p>`,
+			expected: `<p>ab</p>`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := sanitizeHTML(tc.input, tc.base); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	neturl "net/url"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/net/html/charset"
+)
+
+// xmlNamespace is the namespace encoding/xml reports for the reserved xml: prefix.
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// urlResolver turns URLs found in a feed into absolute http(s) URLs relative to its base.
+type urlResolver struct {
+	base *neturl.URL
+}
+
+// resolve returns raw as an absolute http(s) URL, or "" when it cannot be made into one.
+func (r urlResolver) resolve(raw string) string {
+	if resolved := r.resolveURL(raw); resolved != nil {
+		return resolved.String()
+	}
+	return ""
+}
+
+// resolveOptional is resolve for optional proto fields, returning nil instead of "".
+func (r urlResolver) resolveOptional(raw string) *string {
+	return optionalString(r.resolve(raw))
+}
+
+// resolveURL parses raw and resolves it against the base, rejecting anything but absolute http(s) URLs.
+func (r urlResolver) resolveURL(raw string) *neturl.URL {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+
+	parsed, err := neturl.Parse(raw)
+	if err != nil {
+		return nil
+	}
+	if r.base != nil {
+		parsed = r.base.ResolveReference(parsed)
+	}
+
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil
+	}
+	return parsed
+}
+
+// rebase returns a resolver based at raw, keeping the current base when raw does not resolve.
+func (r urlResolver) rebase(raw string) urlResolver {
+	if resolved := r.resolveURL(raw); resolved != nil {
+		return urlResolver{base: resolved}
+	}
+	return r
+}
+
+// feedResolvers returns the resolvers for a feed's own link and for everything else it references. URLs are
+// resolved against the channel's xml:base, then the channel link, then the URL the document was fetched from.
+func (o conversionOptions) feedResolvers(feedURL string, feed *gofeed.Feed) (channel, content urlResolver) {
+	channel = urlResolver{base: o.documentURL}
+	if channel.base == nil {
+		channel.base, _ = neturl.Parse(strings.TrimSpace(feedURL))
+	}
+	if base := o.xmlBases.channelBase(); base != nil {
+		channel.base = base
+		return channel, channel
+	}
+	return channel, channel.rebase(feed.Link)
+}
+
+// itemResolver returns the resolver for the item at index, honouring an xml:base declared on it.
+func (o conversionOptions) itemResolver(index int, fallback urlResolver) urlResolver {
+	if base := o.xmlBases.itemBase(index); base != nil {
+		return urlResolver{base: base}
+	}
+	return fallback
+}
+
+// xmlBases records the xml:base declared for the channel and for each item of an XML feed. Items are listed in
+// document order with nil entries where no xml:base is in scope.
+type xmlBases struct {
+	channel *neturl.URL
+	items   []*neturl.URL
+}
+
+// itemBase returns the xml:base in scope for the item at index, or nil.
+func (b *xmlBases) itemBase(index int) *neturl.URL {
+	if b == nil || index < 0 || index >= len(b.items) {
+		return nil
+	}
+	return b.items[index]
+}
+
+// channelBase returns the xml:base in scope for the channel, or nil.
+func (b *xmlBases) channelBase() *neturl.URL {
+	if b == nil {
+		return nil
+	}
+	return b.channel
+}
+
+// scanXMLBases collects xml:base declarations from an RSS or Atom document, resolving nested values against
+// documentURL. It returns nil when the document declares none, which is by far the common case.
+func scanXMLBases(body []byte, documentURL *neturl.URL) *xmlBases {
+	if !bytes.Contains(body, []byte("xml:base")) {
+		return nil
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	decoder.Strict = false
+	decoder.CharsetReader = charset.NewReaderLabel
+
+	type scope struct {
+		base     *neturl.URL
+		explicit bool
+	}
+
+	bases := &xmlBases{}
+	stack := []scope{{base: documentURL}}
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			// Malformed documents still yield whatever was collected; gofeed reports the parse error itself.
+			return bases
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			current := stack[len(stack)-1]
+			for _, attr := range element.Attr {
+				if attr.Name.Local != "base" || (attr.Name.Space != xmlNamespace && attr.Name.Space != "xml") {
+					continue
+				}
+				if resolved := (urlResolver{base: current.base}).resolveURL(attr.Value); resolved != nil {
+					current = scope{base: resolved, explicit: true}
+				}
+			}
+			stack = append(stack, current)
+
+			switch strings.ToLower(element.Name.Local) {
+			case "channel", "feed":
+				if current.explicit && bases.channel == nil {
+					bases.channel = current.base
+				}
+			case "item", "entry":
+				if current.explicit {
+					bases.items = append(bases.items, current.base)
+				} else {
+					bases.items = append(bases.items, nil)
+				}
+			}
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+}
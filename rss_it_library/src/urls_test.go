@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	neturl "net/url"
+	"strings"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	pb "github.com/sunderee/rss-it/proto"
+)
+
+const testRelativeFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+  <channel>
+    <title>Relative Feed</title>
+    <link>/blog/</link>
+    <image><url>images/logo.png</url></image>
+    <itunes:image href="cover.jpg"/>
+    <item>
+      <title>Relative link</title>
+      <link>posts/1</link>
+      <description>&lt;img src="pic.png"&gt;</description>
+      <enclosure url="audio/1.mp3" type="audio/mpeg" length="10"/>
+      <enclosure url="ftp://files.example.com/1.mp3" type="audio/mpeg" length="10"/>
+    </item>
+    <item xml:base="https://cdn.example.org/section/">
+      <title>Item with xml:base</title>
+      <link>story</link>
+    </item>
+    <item>
+      <title>Unusable link</title>
+      <link>javascript:alert(1)</link>
+    </item>
+  </channel>
+</rss>`
+
+func TestURLResolver_Resolve(t *testing.T) {
+	base, _ := neturl.Parse("https://example.com/blog/post")
+	resolver := urlResolver{base: base}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "https://other.example.com/a", expected: "https://other.example.com/a"},
+		{input: "HTTP://Example.com/upper", expected: "http://Example.com/upper"},
+		{input: "/root", expected: "https://example.com/root"},
+		{input: "sibling", expected: "https://example.com/blog/sibling"},
+		{input: "//cdn.example.com/x.png", expected: "https://cdn.example.com/x.png"},
+		{input: "  spaced  ", expected: "https://example.com/blog/spaced"},
+		{input: "", expected: ""},
+		{input: "javascript:alert(1)", expected: ""},
+		{input: "mailto:someone@example.com", expected: ""},
+		{input: "data:image/png;base64,AAAA", expected: ""},
+		{input: "ftp://files.example.com/a", expected: ""},
+	}
+
+	for _, tc := range tests {
+		if got := resolver.resolve(tc.input); got != tc.expected {
+			t.Errorf("resolve(%q) = %q, want %q", tc.input, got, tc.expected)
+		}
+	}
+
+	if got := (urlResolver{}).resolve("/relative"); got != "" {
+		t.Errorf("expected relative URL without base to be dropped, got %q", got)
+	}
+}
+
+func TestScanXMLBases(t *testing.T) {
+	document, _ := neturl.Parse("https://example.com/feed.xml")
+	body := []byte(`<?xml version="1.0"?>
+<rss version="2.0" xml:base="https://example.com/root/">
+  <channel>
+    <item><link>a</link></item>
+    <item xml:base="nested/"><link>b</link></item>
+  </channel>
+</rss>`)
+
+	bases := scanXMLBases(body, document)
+	if bases == nil {
+		t.Fatal("expected xml:base declarations to be collected")
+	}
+	if got := bases.channelBase().String(); got != "https://example.com/root/" {
+		t.Errorf("unexpected channel base %q", got)
+	}
+	if len(bases.items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(bases.items))
+	}
+	if got := bases.itemBase(0).String(); got != "https://example.com/root/" {
+		t.Errorf("expected inherited base for first item, got %q", got)
+	}
+	if got := bases.itemBase(1).String(); got != "https://example.com/root/nested/" {
+		t.Errorf("expected nested base for second item, got %q", got)
+	}
+	if bases.itemBase(5) != nil {
+		t.Error("expected nil base for out-of-range item")
+	}
+
+	if scanXMLBases([]byte(testRSSFeed), document) != nil {
+		t.Error("expected nil when no xml:base is declared")
+	}
+}
+
+func TestRSSParser_ParseFeeds_ResolvesRelativeURLs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testRelativeFeed))
+	}))
+	defer server.Close()
+
+	parser := NewRSSParser(gofeed.NewParser, defaultParserConcurrency, nil)
+	response := parser.ParseFeeds(context.Background(), &pb.ParseFeedsRequest{Urls: []string{server.URL + "/feeds/main.xml"}})
+	if response.Status != pb.ParseFeedsStatus_SUCCESS {
+		t.Fatalf("expected SUCCESS, got %v (errors: %v)", response.Status, response.Errors)
+	}
+
+	feed := response.Feeds[0]
+	site := server.URL + "/blog/"
+	if feed.GetLink() != site {
+		t.Errorf("expected channel link resolved against the fetch URL, got %q", feed.GetLink())
+	}
+	if feed.GetImage() != site+"images/logo.png" {
+		t.Errorf("expected feed image resolved against the channel link, got %q", feed.GetImage())
+	}
+	if feed.GetPodcast().GetImage() != site+"cover.jpg" {
+		t.Errorf("expected podcast image resolved, got %q", feed.GetPodcast().GetImage())
+	}
+
+	first := feed.Items[0]
+	if first.GetLink() != site+"posts/1" {
+		t.Errorf("expected item link resolved against the channel link, got %q", first.GetLink())
+	}
+	if !strings.Contains(first.GetContent(), `src="`+site+`posts/pic.png"`) {
+		t.Errorf("expected content URLs resolved against the item link, got %q", first.GetContent())
+	}
+	if len(first.Enclosures) != 1 || first.Enclosures[0].Url != site+"audio/1.mp3" {
+		t.Errorf("expected only the http enclosure, resolved, got %+v", first.Enclosures)
+	}
+
+	if got := feed.Items[1].GetLink(); got != "https://cdn.example.org/section/story" {
+		t.Errorf("expected item link resolved against xml:base, got %q", got)
+	}
+	if feed.Items[2].Link != nil {
+		t.Errorf("expected javascript: link to be dropped, got %q", feed.Items[2].GetLink())
+	}
+}
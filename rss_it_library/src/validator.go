@@ -34,7 +34,7 @@ func NewRSSValidator(newParser func() *gofeed.Parser, timeout time.Duration) *RS
 	}
 }
 
-// ValidateFeedURL checks that a feed can be fetched and parsed within the configured timeout.
+// ValidateFeedURL checks that a feed can be fetched and parsed within the request's timeout, or the configured one.
 func (v *RSSValidator) ValidateFeedURL(ctx context.Context, request *pb.ValidateFeedRequest) *pb.ValidateFeedResponse {
 	if ctx == nil {
 		ctx = context.Background()
@@ -53,18 +53,26 @@ func (v *RSSValidator) ValidateFeedURL(ctx context.Context, request *pb.Validate
 		return response
 	}
 
+	canonicalURL, err := normalizeFeedURL(feedURL, false)
+	if err != nil {
+		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, err.Error(), feedURL)
+		return response
+	}
+	response.CanonicalUrl = canonicalURL
+
 	parser := v.newParser()
 
-	parseCtx, cancel := context.WithTimeout(ctx, v.timeout)
+	parseCtx, cancel := context.WithTimeout(ctx, durationOrDefault(request.GetTimeoutMs(), v.timeout))
 	defer cancel()
 
-	feed, err := parser.ParseURLWithContext(feedURL, parseCtx)
+	result, err := fetchFeed(parseCtx, parser, canonicalURL, nil, defaultMaxBodyBytes)
 	if err != nil {
-		response.Error = newErrorDetail(classifyParseError(err), err.Error(), feedURL)
+		response.Error = newFetchErrorDetail(err, feedURL)
+		response.Error.CanonicalUrl = canonicalURL
 		return response
 	}
 
-	if feed == nil {
+	if result.feed == nil {
 		response.Error = newErrorDetail(pb.ErrorKind_ERROR_KIND_VALIDATION, "no feed data returned", feedURL)
 		return response
 	}
@@ -2,7 +2,10 @@ package main
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/mmcdole/gofeed"
 	pb "github.com/sunderee/rss-it/proto"
@@ -98,3 +101,28 @@ func TestRSSValidator_ValidateFeedURL_ResponseStructure(t *testing.T) {
 	// Valid field should be set (either true or false)
 	_ = response.Valid
 }
+
+func TestRSSValidator_ValidateFeedURL_TimeoutOverride(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	validator := NewRSSValidator(gofeed.NewParser, defaultValidationTimeout)
+
+	start := time.Now()
+	response := validator.ValidateFeedURL(context.Background(), &pb.ValidateFeedRequest{
+		Url:       server.URL,
+		TimeoutMs: 100,
+	})
+
+	if response.Valid {
+		t.Fatal("expected slow feed to fail validation")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected request timeout to apply, took %v", elapsed)
+	}
+}
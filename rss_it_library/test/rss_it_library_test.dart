@@ -43,6 +43,45 @@ void main() {
       expect(deserialized.urls[1], equals('https://example.com/rss2.xml'));
     });
 
+    test('CancelRequest carries the request ID of the call to abort', () {
+      const requestId = 'add-feed-sheet';
+      final validate = ValidateFeedRequest(
+        url: 'https://example.com/rss.xml',
+        requestId: requestId,
+      );
+      final cancel = CancelRequest(requestId: requestId);
+
+      final deserializedValidate = ValidateFeedRequest.fromBuffer(
+        validate.writeToBuffer(),
+      );
+      final deserializedCancel = CancelRequest.fromBuffer(
+        cancel.writeToBuffer(),
+      );
+      expect(deserializedValidate.requestId, equals(requestId));
+      expect(deserializedCancel.requestId, equals(requestId));
+
+      final response = CancelResponse.fromBuffer(
+        CancelResponse(cancelled: true).writeToBuffer(),
+      );
+      expect(response.cancelled, isTrue);
+    });
+
+    test('ParseStreamEvent carries exactly one event', () {
+      final event = ParseStreamEvent(
+        feed: Feed(url: 'https://example.com/rss.xml', title: 'Test Feed'),
+      );
+      final deserialized = ParseStreamEvent.fromBuffer(event.writeToBuffer());
+      expect(deserialized.whichEvent(), equals(ParseStreamEvent_Event.feed));
+      expect(deserialized.feed.title, equals('Test Feed'));
+
+      deserialized.summary = ParseStreamSummary(
+        status: ParseFeedsStatus.SUCCESS,
+        feedCount: 1,
+      );
+      expect(deserialized.whichEvent(), equals(ParseStreamEvent_Event.summary));
+      expect(deserialized.hasFeed(), isFalse);
+    });
+
     test('ParseFeedsResponse serialization and deserialization', () {
       final feed = Feed()
         ..url = 'https://example.com/rss.xml'